@@ -0,0 +1,200 @@
+package cluster
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	retry "github.com/avast/retry-go"
+	hf "github.com/kyma-incubator/hydroform/provision"
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/eksctl"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/pkg/step"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// ErrNotConfirmed is returned when the user does not confirm the deprovisioning
+var ErrNotConfirmed = errors.New("Deprovisioning aborted")
+
+var providerNames = map[types.ProviderType]string{
+	types.GCP:      "GCP",
+	types.Azure:    "Azure",
+	types.Gardener: "Gardener",
+	types.AWS:      "AWS",
+}
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new command deprovisioning clusters of the provider given in the options
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	name := providerNames[o.Provider]
+	cmd := &cobra.Command{
+		Use:   string(o.Provider),
+		Short: fmt.Sprintf("Deprovisions a cluster provisioned on %s with the Kyma CLI.", name),
+		Long: fmt.Sprintf(`Use this command to remove a cluster which you provisioned on %s with the `+"`kyma provision %s`"+` command.
+The command uses the cluster state stored in the Kyma CLI home directory. It removes the cluster, its kubeconfig entries, and the stored state.`, name, o.Provider),
+
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+	if o.Provider == types.AWS {
+		cmd.Long += "\nAWS clusters are removed with eksctl, make sure it is installed. Their project is the AWS profile they were provisioned with, and the credentials flag is not used."
+	}
+
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the cluster to deprovision. (required)")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Project of the cluster. Only needed if clusters with the same name exist in several projects.")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the credentials file to use instead of the one the cluster was provisioned with.")
+
+	return cmd
+}
+
+//Run deprovisions the cluster given in the options, for other commands which remove clusters as one of their steps
+func Run(o *Options) error {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+	return c.Run()
+}
+
+func (c *command) Run() error {
+	if c.opts.Name == "" {
+		return errors.New("Required flag `name` has not been set.")
+	}
+	name := providerNames[c.opts.Provider]
+
+	s := c.NewStep("Loading cluster state")
+	home, err := files.KymaHome()
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	state, err := clusters.Find(home, c.opts.Provider, c.opts.Project, c.opts.Name)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	if state.Provider == types.AWS {
+		s.Successf("Cluster state loaded from %s", state.Dir)
+		return c.deprovisionEKS(state)
+	}
+	cluster, provider, err := state.Hydroform(c.opts.CredentialsFile)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Cluster state loaded from %s", state.Dir)
+
+	if !c.confirm(s, state) {
+		return ErrNotConfirmed
+	}
+
+	if !c.opts.Verbose {
+		// discard all the noise from terraform logs if not verbose
+		log.SetOutput(ioutil.Discard)
+	}
+
+	// the kubeconfig can only be retrieved as long as the cluster exists
+	var kubeconfig []byte
+	if state.HasState() {
+		if kubeconfig, err = hf.Credentials(cluster, provider, types.WithDataDir(home), types.Persistent()); err != nil {
+			kubeconfig = nil
+			s.LogInfof("Unable to get the kubeconfig of the cluster, its entries are not removed from your kubeconfig: %s", err)
+		}
+	}
+
+	s = c.NewStep(fmt.Sprintf("Deprovisioning %s cluster", name))
+	if state.HasState() {
+		err = c.Interruptible(func() error {
+			return retry.Do(
+				func() error {
+					return hf.Deprovision(cluster, provider, types.WithDataDir(home), types.Persistent())
+				},
+				retry.Attempts(3))
+		})
+		if err != nil {
+			s.Failure()
+			if c.Interrupted() {
+				return errors.Wrapf(err, "Deprovisioning interrupted. The %s cluster '%s' may be left half-deprovisioned, run the command again to finish it", name, state.Name)
+			}
+			return err
+		}
+	} else {
+		s.LogInfo("No cluster state was persisted, the cluster was probably never provisioned successfully")
+	}
+	s.Success()
+
+	return c.removeState(state, kubeconfig)
+}
+
+func (c *command) confirm(s step.Step, state *clusters.Cluster) bool {
+	return c.opts.NonInteractive || s.PromptYesNo(fmt.Sprintf("Do you really want to deprovision the %s cluster '%s' in '%s'? ", providerNames[state.Provider], state.Name, state.Project))
+}
+
+// deprovisionEKS deletes an AWS EKS cluster with eksctl, as hydroform does not support AWS
+func (c *command) deprovisionEKS(state *clusters.Cluster) error {
+	s := c.NewStep("Checking eksctl")
+	if err := eksctl.CheckInstalled(); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+	if !c.confirm(s, state) {
+		return ErrNotConfirmed
+	}
+
+	region := state.Spec().Location
+	// the kubeconfig can only be retrieved as long as the cluster exists
+	kubeconfig, err := eksctl.Kubeconfig(c.Context(), c.opts.Verbose, state.AWSProfile(), state.Name, region)
+	if err != nil {
+		kubeconfig = nil
+		s.LogInfof("Unable to get the kubeconfig of the cluster, its entries are not removed from your kubeconfig: %s", err)
+	}
+
+	s = c.NewStep("Deprovisioning AWS cluster")
+	s.Status("This takes about 15 minutes")
+	if _, err := eksctl.RunCmd(c.Context(), c.opts.Verbose, state.AWSProfile(), "delete", "cluster", "--name", state.Name, "--region", region, "--wait"); err != nil {
+		s.Failure()
+		if c.Interrupted() {
+			return errors.Wrapf(err, "Deprovisioning interrupted. The AWS cluster '%s' may be left half-deprovisioned, run the command again to finish it", state.Name)
+		}
+		return err
+	}
+	s.Success()
+
+	return c.removeState(state, kubeconfig)
+}
+
+// removeState removes the kubeconfig entries and the persisted state of a deprovisioned cluster
+func (c *command) removeState(state *clusters.Cluster, kubeconfig []byte) error {
+	if kubeconfig != nil {
+		s := c.NewStep("Removing kubeconfig entries")
+		if err := kube.RemoveConfig(kubeconfig, c.opts.KubeconfigPath); err != nil {
+			s.Failure()
+			return err
+		}
+		s.Success()
+	}
+
+	s := c.NewStep("Removing cluster state")
+	if err := state.Remove(); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+
+	fmt.Printf("\n%s cluster '%s' deprovisioned\n", providerNames[state.Provider], state.Name)
+	return nil
+}
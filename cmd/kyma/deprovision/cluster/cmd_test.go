@@ -0,0 +1,38 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeprovisionFlags ensures that the provided command flags are stored in the options.
+func TestDeprovisionFlags(t *testing.T) {
+	o := NewOptions(&cli.Options{}, types.Gardener)
+	c := NewCmd(o)
+
+	require.Equal(t, "gardener", c.Use, "Command name not as expected.")
+
+	// test default flag values
+	require.Equal(t, "", o.Name, "Default value for the name flag not as expected.")
+	require.Equal(t, "", o.Project, "Default value for the project flag not as expected.")
+	require.Equal(t, "", o.CredentialsFile, "Default value for the credentials flag not as expected.")
+
+	// test passing flags
+	err := c.ParseFlags([]string{
+		"-n", "my-cluster",
+		"-p", "my-project",
+		"-c", "/my/credentials/file",
+	})
+	require.NoError(t, err, "Parsing flags should not return an error")
+	require.Equal(t, "my-cluster", o.Name, "The parsed value for the name flag not as expected.")
+	require.Equal(t, "my-project", o.Project, "The parsed value for the project flag not as expected.")
+	require.Equal(t, "/my/credentials/file", o.CredentialsFile, "The parsed value for the credentials flag not as expected.")
+}
+
+func TestDeprovisionRequiresName(t *testing.T) {
+	c := command{opts: NewOptions(&cli.Options{}, types.GCP)}
+	require.Error(t, c.Run())
+}
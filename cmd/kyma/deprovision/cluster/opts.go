@@ -0,0 +1,21 @@
+package cluster
+
+import (
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+//Options defines available options for the cluster deprovisioning commands
+type Options struct {
+	*cli.Options
+
+	Provider        types.ProviderType
+	Name            string
+	Project         string
+	CredentialsFile string
+}
+
+//NewOptions creates options with default values for the given provider
+func NewOptions(o *cli.Options, provider types.ProviderType) *Options {
+	return &Options{Options: o, Provider: provider}
+}
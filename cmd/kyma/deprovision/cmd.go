@@ -0,0 +1,14 @@
+package deprovision
+
+import (
+	"github.com/spf13/cobra"
+)
+
+//NewCmd creates a new deprovision command
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprovision",
+		Short: "Removes a cluster provisioned with the Kyma CLI.",
+	}
+	return cmd
+}
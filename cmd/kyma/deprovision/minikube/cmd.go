@@ -0,0 +1,82 @@
+package minikube
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/minikube"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// ErrNotConfirmed is returned when the user does not confirm the deprovisioning
+var ErrNotConfirmed = errors.New("Deprovisioning aborted")
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new minikube command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "minikube",
+		Short: "Deprovisions Minikube.",
+		Long: `Use this command to remove a Minikube cluster which you provisioned with the ` + "`kyma provision minikube`" + ` command.
+Minikube removes the cluster together with its kubeconfig entries and local data.`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Aliases: []string{"m"},
+	}
+
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the Minikube profile to deprovision. If not set, the default profile is used.")
+	return cmd
+}
+
+//Run deprovisions the Minikube profile given in the options, for other commands which remove Minikube as one of their steps
+func Run(o *Options) error {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+	return c.Run()
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	s := c.NewStep("Checking Minikube status")
+	status, _ := minikube.RunCmd(c.opts.Verbose, c.opts.Name, "status", "--format", "{{.Host}}")
+	if strings.TrimSpace(status) == "" {
+		s.Failure()
+		return fmt.Errorf("Minikube profile '%s' not found", c.profileName())
+	}
+	s.Successf("Minikube status verified")
+
+	if !c.opts.NonInteractive {
+		if !s.PromptYesNo(fmt.Sprintf("Do you really want to deprovision the Minikube profile '%s'? ", c.profileName())) {
+			return ErrNotConfirmed
+		}
+	}
+
+	s = c.NewStep("Deprovisioning Minikube")
+	if _, err := minikube.RunCmd(c.opts.Verbose, c.opts.Name, "delete"); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+
+	fmt.Printf("\nMinikube profile '%s' deprovisioned\n", c.profileName())
+	return nil
+}
+
+func (c *command) profileName() string {
+	if c.opts.Name == "" {
+		return "minikube"
+	}
+	return c.opts.Name
+}
@@ -4,17 +4,22 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
-	"html/template"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/template"
 
+	"github.com/kyma-project/cli/internal/templates"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// templateSuffix marks the files which are rendered as templates
+const templateSuffix = ".tmpl"
+
 //NewCmd creates a new provision command
 func NewCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -38,6 +43,7 @@ type Config struct {
 	File          string    `json:"file"`
 	Expose        bool      `json:"expose"`
 	ClusterDomain string    `json:"clusterDomain"`
+	Runtime       string    `json:"runtime"`
 	Triggers      *[]string `json:"triggers"`
 }
 
@@ -48,7 +54,7 @@ func GetConfig(workdir string) (*Config, *string, error) {
 	currentDir := workdir
 	var err error
 	if len(workdir) == 0 {
-		currentDir, err = filepath.Abs(filepath.Dir(os.Args[0]))
+		currentDir, err = os.Getwd()
 		if err != nil {
 			return nil, nil, err
 		}
@@ -72,26 +78,21 @@ func GetConfig(workdir string) (*Config, *string, error) {
 	return config, &currentDir, err
 }
 
-func ProcessTemplateFile(outputpath string, templateFolder string, path string, params interface{}) error {
-	fi, err := os.Stat(path)
+//ProcessTemplateFile creates the directory or file of the template at the path in the output path.
+//The path is relative to the file system, files ending with .tmpl are rendered with the parameters, other files are copied.
+func ProcessTemplateFile(fs templates.FS, outputpath string, templateFolder string, path string, info os.FileInfo, params interface{}) error {
+	templatePath, err := filepath.Rel(templateFolder, path)
 	if err != nil {
 		return errors.Wrap(err, "could not find file")
 	}
+	outputPath := filepath.Join(outputpath, templatePath)
 
-	switch mode := fi.Mode(); {
+	switch mode := info.Mode(); {
 	case mode.IsDir():
-		templatePath := path[len(templateFolder)+1:]
-		outputPath := filepath.Join(outputpath, templatePath)
-
-		err = EnsureDir(outputPath)
-		if err != nil {
-			return err
-		}
+		return EnsureDir(outputPath)
 
 	case mode.IsRegular():
-		templatePath := path[len(templateFolder)+1 : len(path)-5]
-		outputPath := filepath.Join(outputpath, templatePath)
-		CreateFromTemplate(path, outputPath, params)
+		return CreateFromTemplate(fs, path, strings.TrimSuffix(outputPath, templateSuffix), params)
 	}
 
 	return nil
@@ -112,33 +113,36 @@ func EnsureDir(path string) error {
 	return nil
 }
 
-func CreateFromTemplate(path string, outputPath string, params interface{}) error {
-	if !FileExists(outputPath) {
-		b, err := ioutil.ReadFile(path)
-		if err != nil {
-			return errors.Wrap(err, "Cannot read templates")
-		}
-		stringTemplate := string(b)
+//CreateFromTemplate renders the template file of the file system into the output path unless it exists
+func CreateFromTemplate(fs templates.FS, path string, outputPath string, params interface{}) error {
+	if FileExists(outputPath) {
+		log.Infof("[SKIP] %s", outputPath)
+		return nil
+	}
 
-		tmpl, err := template.New("out").Parse(stringTemplate)
-		if err != nil {
-			return errors.Wrap(err, "Cannot parse templates")
-		}
+	b, err := fs.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "Cannot read templates")
+	}
+	if !strings.HasSuffix(path, templateSuffix) {
 		log.Infof("[CREATE] %s", outputPath)
-		f, err := os.Create(outputPath)
-		defer f.Close()
-		if err != nil {
-			return errors.Wrap(err, "Failed to create file")
-		}
-		w := bufio.NewWriter(f)
-		err = tmpl.Execute(w, params)
-		if err != nil {
-			return errors.Wrap(err, "Cannot execute template")
-		}
-		w.Flush()
-	} else {
-		log.Infof("[SKIP] %s", outputPath)
+		return errors.Wrap(ioutil.WriteFile(outputPath, b, 0644), "Failed to create file")
 	}
 
-	return nil
+	tmpl, err := template.New("out").Parse(string(b))
+	if err != nil {
+		return errors.Wrap(err, "Cannot parse templates")
+	}
+	log.Infof("[CREATE] %s", outputPath)
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create file")
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	err = tmpl.Execute(w, params)
+	if err != nil {
+		return errors.Wrap(err, "Cannot execute template")
+	}
+	return w.Flush()
 }
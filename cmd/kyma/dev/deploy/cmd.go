@@ -23,6 +23,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+const defaultRuntime = "nodejs8"
+
 type command struct {
 	opts *Options
 	cli.Command
@@ -38,11 +40,13 @@ func NewCmd(o *Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy Lambda function to cluster",
-		Long:  `Creates a new local lambda function setup to start development`,
-		RunE:  func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Long: `Deploys the Lambda function defined in the kyma.json file of the working directory to the cluster.
+With the --watch flag, the function is deployed again whenever its code, package.json, requirements.txt, or kyma.json change. After each deployment, the command waits for the new function pod to become ready and streams its logs. If the function did not change, the logs of its running pod are streamed right away.`,
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
 	}
 
 	cmd.Flags().StringVarP(&o.WorkingDir, "workdir", "d", "", "Directory where to run the command")
+	cmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, "Deploys the function again whenever its files change, and streams the logs of the function")
 
 	return cmd
 }
@@ -66,6 +70,10 @@ func (cmd *command) Run() error {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
 	}
 
+	if cmd.opts.Watch {
+		return cmd.watch()
+	}
+
 	err = deployFunctionFromPath(cmd.K8s, cmd.opts.WorkingDir)
 	if err != nil {
 		return err
@@ -89,7 +97,7 @@ func deployFunctionFromPath(kube kube.KymaKube, workdir string) error {
 		return err
 	}
 
-	err = deployFunctionForConfig(config, *currentDir, kube)
+	_, err = deployFunctionForConfig(config, *currentDir, kube)
 	if err != nil {
 		return err
 	}
@@ -97,23 +105,25 @@ func deployFunctionFromPath(kube kube.KymaKube, workdir string) error {
 	return nil
 }
 
-func deployFunctionForConfig(config *dev.Config, currentDir string, kube kube.KymaKube) error {
-	err := ensureFunction(config, currentDir, kube)
+// deployFunctionForConfig deploys the function and returns whether its pods are replaced
+func deployFunctionForConfig(config *dev.Config, currentDir string, kube kube.KymaKube) (bool, error) {
+	rollout, err := ensureFunction(config, currentDir, kube)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if config.Expose {
 		err := ensureApi(config, currentDir, kube)
 		if err != nil {
-			return err
+			return false, err
 		}
 	}
 
-	return nil
+	return rollout, nil
 }
 
-func ensureFunction(config *dev.Config, currentDir string, kube kube.KymaKube) error {
+// ensureFunction creates or updates the function and returns whether its pods are replaced, which is the case if the code, dependencies, or runtime changed
+func ensureFunction(config *dev.Config, currentDir string, kube kube.KymaKube) (bool, error) {
 	functionRes := schema.GroupVersionResource{
 		Group:    "kubeless.io",
 		Version:  "v1beta1",
@@ -122,39 +132,56 @@ func ensureFunction(config *dev.Config, currentDir string, kube kube.KymaKube) e
 	itm, err := kube.Dynamic().Resource(functionRes).Namespace(config.Namespace).Get(config.Name, metav1.GetOptions{})
 	if err != nil {
 		if !k8sErrors.IsNotFound(err) {
-			return errors.Wrap(err, "Failed to check Application")
+			return false, errors.Wrap(err, "Failed to check Application")
 		}
 	}
 	sourceFilePath := filepath.Join(currentDir, config.File)
 	if !dev.FileExists(sourceFilePath) {
-		return errors.New(fmt.Sprintf("Referenced file in kyma.json does not exist at %s", sourceFilePath))
+		return false, errors.New(fmt.Sprintf("Referenced file in kyma.json does not exist at %s", sourceFilePath))
 	}
 
 	b, err := ioutil.ReadFile(sourceFilePath)
 	if err != nil {
-		return errors.Wrap(err, "Cannot read config file")
+		return false, errors.Wrap(err, "Cannot read config file")
 	}
 	functionCode := string(b)
 
 	checksum, err := getChecksum(functionCode)
 	if err != nil {
-		return errors.Wrap(err, "Cannot generate checksum")
+		return false, errors.Wrap(err, "Cannot generate checksum")
 	}
 	checksum = fmt.Sprintf("sha256:%s", checksum)
 
+	runtime := config.Runtime
+	if runtime == "" {
+		// functions created before the runtime was stored in kyma.json are all nodejs8 functions
+		runtime = defaultRuntime
+	}
+
 	var dependencies *string
+	rollout := false
 	packageFile := filepath.Join(currentDir, "package.json")
-	if dev.FileExists(packageFile) {
+	requirementsFile := filepath.Join(currentDir, "requirements.txt")
+	if strings.HasPrefix(runtime, "python") {
+		if dev.FileExists(requirementsFile) {
+			b, err := ioutil.ReadFile(requirementsFile)
+			if err != nil {
+				return false, errors.Wrap(err, "Cannot read requirements")
+			}
+			depsStr := string(b)
+			dependencies = &depsStr
+		}
+	} else if dev.FileExists(packageFile) {
 		b, err := ioutil.ReadFile(packageFile)
 		if err != nil {
-			return errors.Wrap(err, "Cannot read package json")
+			return false, errors.Wrap(err, "Cannot read package json")
 		}
 		var result map[string]interface{}
 		json.Unmarshal([]byte(b), &result)
 		if dep, ok := result["dependencies"]; ok {
 			depArr, err := json.Marshal(dep)
 			if err != nil {
-				return errors.Wrap(err, "Cannot read package json")
+				return false, errors.Wrap(err, "Cannot read package json")
 			}
 			depsStr := fmt.Sprintf("{\n \"dependencies\": %s \n}", string(depArr))
 			dependencies = &depsStr
@@ -163,23 +190,31 @@ func ensureFunction(config *dev.Config, currentDir string, kube kube.KymaKube) e
 	}
 
 	if itm != nil {
+		oldChecksum, _, _ := unstructured.NestedString(itm.Object, "spec", "checksum")
+		oldDeps, _, _ := unstructured.NestedString(itm.Object, "spec", "deps")
+		oldRuntime, _, _ := unstructured.NestedString(itm.Object, "spec", "runtime")
+		rollout = oldChecksum != checksum || oldRuntime != runtime || (dependencies != nil && oldDeps != *dependencies)
+
 		// Update Function parameter
 		log.Infof("[UPDATE] Updating Function - functions.kubeless.io/v1beta1 %s/%s", config.Namespace, config.Name)
 		if err := unstructured.SetNestedField(itm.Object, functionCode, "spec", "function"); err != nil {
-			errors.Wrap(err, "Failed to update function.")
+			return false, errors.Wrap(err, "Failed to update function.")
 		}
 		if err := unstructured.SetNestedField(itm.Object, checksum, "spec", "checksum"); err != nil {
-			errors.Wrap(err, "Failed to update checksum.")
+			return false, errors.Wrap(err, "Failed to update checksum.")
+		}
+		if err := unstructured.SetNestedField(itm.Object, runtime, "spec", "runtime"); err != nil {
+			return false, errors.Wrap(err, "Failed to update runtime.")
 		}
 		if dependencies != nil {
 			if err := unstructured.SetNestedField(itm.Object, *dependencies, "spec", "deps"); err != nil {
-				errors.Wrap(err, "Failed to update function.")
+				return false, errors.Wrap(err, "Failed to update function.")
 			}
 		}
 
 		_, err := kube.Dynamic().Resource(functionRes).Namespace(config.Namespace).Update(itm, metav1.UpdateOptions{})
 		if err != nil {
-			return errors.Wrap(err, "Failed to update function.")
+			return false, errors.Wrap(err, "Failed to update function.")
 		}
 	} else {
 		// Create Function
@@ -196,7 +231,7 @@ func ensureFunction(config *dev.Config, currentDir string, kube kube.KymaKube) e
 				},
 				"spec": map[string]interface{}{
 					"checksum": checksum,
-					"runtime":  "nodejs8",
+					"runtime":  runtime,
 					"type":     "HTTP",
 					"handler":  "handler.main",
 					"function": functionCode,
@@ -207,22 +242,23 @@ func ensureFunction(config *dev.Config, currentDir string, kube kube.KymaKube) e
 
 		if dependencies != nil {
 			if err := unstructured.SetNestedField(newFunction.Object, *dependencies, "spec", "deps"); err != nil {
-				errors.Wrap(err, "Failed to update function.")
+				return false, errors.Wrap(err, "Failed to update function.")
 			}
 		}
 
 		itm, err = kube.Dynamic().Resource(functionRes).Namespace(config.Namespace).Create(newFunction, metav1.CreateOptions{})
 		if err != nil {
-			return errors.Wrap(err, "Failed to create function.")
+			return false, errors.Wrap(err, "Failed to create function.")
 		}
+		rollout = true
 	}
 
 	err = ensureTriggers(config.Name, config.Namespace, config.Triggers, kube)
 	if err != nil {
-		return errors.Wrap(err, "Failed to setup triggers.")
+		return false, errors.Wrap(err, "Failed to setup triggers.")
 	}
 
-	return nil
+	return rollout, nil
 }
 
 func ensureTriggers(functionName string, namespace string, triggers *[]string, kube kube.KymaKube) error {
@@ -5,6 +5,7 @@ import "github.com/kyma-project/cli/internal/cli"
 type Options struct {
 	*cli.Options
 	WorkingDir string
+	Watch      bool
 }
 
 //NewOptions creates options with default values
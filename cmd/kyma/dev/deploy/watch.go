@@ -0,0 +1,204 @@
+package deploy
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kyma-project/cli/cmd/kyma/dev"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	// pollInterval defines how often the watched files are checked for changes
+	pollInterval = 500 * time.Millisecond
+	// quietPeriod is the time without further changes after which the function is deployed, so that rapid edits are deployed once
+	quietPeriod = time.Second
+	// podReadyTimeout is the time the new function pod has to become ready after a deployment
+	podReadyTimeout = 5 * time.Minute
+)
+
+// fileState identifies a version of a watched file, a missing file has the zero state
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// watch deploys the function, streams its logs, and deploys it again on every change of its files until the command is interrupted
+func (cmd *command) watch() error {
+	ctx := cmd.Context()
+	var stopLogs context.CancelFunc = func() {}
+	defer func() { stopLogs() }()
+
+	deploy := func(initial bool) error {
+		config, currentDir, err := dev.GetConfig(cmd.opts.WorkingDir)
+		if err != nil {
+			return err
+		}
+		since := time.Now()
+		rollout, err := deployFunctionForConfig(config, *currentDir, cmd.K8s)
+		if err != nil {
+			return err
+		}
+		if !rollout {
+			log.Info("[SKIP] Function code, dependencies, and runtime did not change")
+			if !initial {
+				return nil
+			}
+			// nothing is rolled out, but the logs of the running function pod are streamed from the start
+			since = time.Time{}
+		}
+
+		log.Infof("[WAIT] Waiting for a ready pod of function %s/%s", config.Namespace, config.Name)
+		pod, err := waitForNewPod(ctx, cmd.K8s, config.Namespace, config.Name, since)
+		if err != nil {
+			return err
+		}
+		log.Infof("[READY] Function pod %s is ready", pod.Name)
+
+		stopLogs()
+		var logsCtx context.Context
+		logsCtx, stopLogs = context.WithCancel(ctx)
+		go streamLogs(logsCtx, cmd.K8s.Static(), pod, config.Name)
+		return nil
+	}
+
+	if err := deploy(true); err != nil {
+		return err
+	}
+	log.Info("Watching for changes, press Ctrl+C to stop")
+
+	err := watchFiles(ctx, func() []string { return watchedFiles(cmd.opts.WorkingDir) }, pollInterval, quietPeriod, func() {
+		// a failed deployment must not end the watch, the next change may fix it
+		if err := deploy(false); err != nil && ctx.Err() == nil {
+			log.Errorf("Deployment failed: %s", err)
+		}
+	})
+	if err == context.Canceled {
+		return nil
+	}
+	return err
+}
+
+// watchedFiles returns the function file, package.json, requirements.txt, and kyma.json of the working directory
+func watchedFiles(workdir string) []string {
+	config, currentDir, err := dev.GetConfig(workdir)
+	if err != nil {
+		// kyma.json is missing or broken, watch it until it is fixed
+		dir := workdir
+		if currentDir != nil {
+			dir = *currentDir
+		}
+		return []string{filepath.Join(dir, "kyma.json")}
+	}
+	return []string{
+		filepath.Join(*currentDir, "kyma.json"),
+		filepath.Join(*currentDir, config.File),
+		filepath.Join(*currentDir, "package.json"),
+		filepath.Join(*currentDir, "requirements.txt"),
+	}
+}
+
+// watchFiles polls the files and calls onChange once the files changed and did not change again for the quiet period.
+// It blocks until the context is done.
+func watchFiles(ctx context.Context, files func() []string, interval, quiet time.Duration, onChange func()) error {
+	last := snapshot(files())
+	var changedAt time.Time
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			current := snapshot(files())
+			if !sameSnapshot(last, current) {
+				last = current
+				changedAt = now
+				continue
+			}
+			if !changedAt.IsZero() && now.Sub(changedAt) >= quiet {
+				changedAt = time.Time{}
+				onChange()
+			}
+		}
+	}
+}
+
+func snapshot(files []string) map[string]fileState {
+	states := map[string]fileState{}
+	for _, f := range files {
+		if info, err := os.Stat(f); err == nil {
+			states[f] = fileState{modTime: info.ModTime(), size: info.Size()}
+		} else {
+			states[f] = fileState{}
+		}
+	}
+	return states
+}
+
+func sameSnapshot(a, b map[string]fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for f, state := range a {
+		if other, ok := b[f]; !ok || other != state {
+			return false
+		}
+	}
+	return true
+}
+
+// waitForNewPod waits until all pods of the function are ready and created after the given time, a zero time accepts any ready pod.
+// Pods of the previous version do not meet the condition, so the wait ends once the rollout replaced them.
+func waitForNewPod(ctx context.Context, k8s kube.KymaKube, namespace, function string, since time.Time) (*corev1.Pod, error) {
+	// creation timestamps are truncated to seconds
+	since = since.Truncate(time.Second)
+	readyAndNew := func(pod *corev1.Pod) bool {
+		return pod.DeletionTimestamp == nil && !pod.CreationTimestamp.Time.Before(since) && kube.PodReady(pod)
+	}
+
+	selector := "function=" + function
+	if err := k8s.WaitPods(ctx, namespace, selector, readyAndNew, podReadyTimeout); err != nil {
+		return nil, errors.Wrapf(err, "Stopped waiting for the new pod of function %s/%s", namespace, function)
+	}
+	pods, err := k8s.Static().CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list function pods")
+	}
+	for i := range pods.Items {
+		if pod := &pods.Items[i]; readyAndNew(pod) {
+			return pod, nil
+		}
+	}
+	return nil, fmt.Errorf("The new pod of function %s/%s was removed right after it became ready", namespace, function)
+}
+
+// streamLogs prints the logs of the function container until the context is done
+func streamLogs(ctx context.Context, k8s kubernetes.Interface, pod *corev1.Pod, function string) {
+	stream, err := k8s.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: function,
+		Follow:    true,
+	}).Context(ctx).Stream()
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Errorf("Cannot stream the logs of pod %s: %s", pod.Name, err)
+		}
+		return
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	for scanner.Scan() {
+		fmt.Printf("[%s] %s\n", function, scanner.Text())
+	}
+}
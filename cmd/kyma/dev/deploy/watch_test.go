@@ -0,0 +1,89 @@
+package deploy
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWatchFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watch")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	handler := filepath.Join(dir, "handler.js")
+	require.NoError(t, ioutil.WriteFile(handler, []byte("v1"), 0644))
+
+	var deployments int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watchFiles(ctx, func() []string { return []string{handler, filepath.Join(dir, "package.json")} }, 10*time.Millisecond, 100*time.Millisecond, func() {
+			atomic.AddInt32(&deployments, 1)
+		})
+	}()
+
+	// rapid edits are deployed once
+	for i := 0; i < 5; i++ {
+		time.Sleep(20 * time.Millisecond)
+		// every edit changes the size, the modification time may be too coarse to tell the edits apart
+		require.NoError(t, ioutil.WriteFile(handler, []byte(strings.Repeat("v", i+3)), 0644))
+	}
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&deployments) == 1 }, time.Second, 10*time.Millisecond)
+
+	// new files are changes as well
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "package.json"), []byte("{}"), 0644))
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&deployments) == 2 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.Equal(t, context.Canceled, <-done)
+	require.Equal(t, int32(2), atomic.LoadInt32(&deployments))
+}
+
+func TestWaitForNewPod(t *testing.T) {
+	since := time.Now()
+	pod := func(name string, created time.Time, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "stage", Labels: map[string]string{"function": "orders"}, CreationTimestamp: metav1.NewTime(created)},
+			Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}}},
+		}
+	}
+	static := fake.NewSimpleClientset(
+		pod("orders-old", since.Add(-time.Hour), corev1.ConditionTrue),
+		pod("orders-new", since.Add(time.Second), corev1.ConditionFalse),
+	)
+	k8s := kube.NewFromClients(static, nil, nil, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := waitForNewPod(ctx, k8s, "stage", "orders", since)
+	require.Error(t, err, "the old pod must not count as the new one and the new one is not ready")
+
+	done := make(chan error)
+	var found *corev1.Pod
+	go func() {
+		var err error
+		found, err = waitForNewPod(context.Background(), k8s, "stage", "orders", since)
+		done <- err
+	}()
+	// the rollout finishes while waiting
+	_, err = static.CoreV1().Pods("stage").Update(pod("orders-new", since.Add(time.Second), corev1.ConditionTrue))
+	require.NoError(t, err)
+	require.NoError(t, static.CoreV1().Pods("stage").Delete("orders-old", &metav1.DeleteOptions{}))
+	require.NoError(t, <-done)
+	require.Equal(t, "orders-new", found.Name)
+
+	found, err = waitForNewPod(context.Background(), k8s, "stage", "orders", time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, "orders-new", found.Name, "without a rollout, the running pod must be found")
+}
@@ -1,6 +1,7 @@
 package newLambda
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/url"
 	"os"
@@ -11,8 +12,10 @@ import (
 	"github.com/kyma-project/cli/cmd/kyma/dev"
 	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/templates"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"gopkg.in/src-d/go-git.v4"
 	"gopkg.in/yaml.v2"
 )
 
@@ -31,8 +34,9 @@ func NewCmd(o *Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "new-lambda",
 		Short: "New local Lambda Function",
-		Long:  `Creates a new local lambda function setup to start development`,
-		RunE:  func(_ *cobra.Command, args []string) error { return c.Run(args) },
+		Long: `Creates a new local lambda function setup to start development.
+The setup is created from the template set of the runtime which is shipped with the Kyma CLI. To use the templates of your company instead, pass a directory or git repository with the --template flag. If it contains a lambda-<runtime> folder, the folder of the selected runtime is used, otherwise the whole directory.`,
+		RunE: func(_ *cobra.Command, args []string) error { return c.Run(args) },
 	}
 
 	cmd.Args = cobra.ExactArgs(1)
@@ -40,6 +44,8 @@ func NewCmd(o *Options) *cobra.Command {
 	cmd.Flags().StringVarP(&o.Namespace, "namespace", "n", "default", "Namespace to bind")
 	cmd.Flags().BoolVar(&o.Expose, "expose", false, "Create the namespace if not existing")
 	cmd.Flags().StringVar(&o.ClusterDomain, "cluster-domain", "", "Cluster Domain of your cluster")
+	cmd.Flags().StringVar(&o.Runtime, "runtime", "nodejs8", "Runtime of the function. Possible values: "+strings.Join(runtimes, ","))
+	cmd.Flags().StringVar(&o.Template, "template", "", "Directory or git URL of custom templates to use instead of the shipped ones")
 
 	return cmd
 }
@@ -60,7 +66,7 @@ func (cmd *command) Run(args []string) error {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
 	}
 
-	err = createNewTemplate(name, cmd.opts.Namespace, cmd.opts.Expose, cmd.opts.ClusterDomain, cmd.K8s)
+	err = createNewTemplate(name, cmd.opts)
 	if err != nil {
 		return err
 	}
@@ -92,6 +98,10 @@ func (c *command) validateFlags() error {
 		c.opts.KubeconfigPath = filepath.Join(usr.HomeDir, ".kube/config")
 	}
 
+	if !runtimeSupported(c.opts.Runtime) {
+		errMessage.WriteString(fmt.Sprintf("\nRuntime %q is not supported, use one of %s.", c.opts.Runtime, strings.Join(runtimes, ", ")))
+	}
+
 	if c.opts.ClusterDomain == "" {
 		clusterDomain, err := getClusterDomainFromKubecofig(c.opts.KubeconfigPath)
 		if err != nil {
@@ -106,19 +116,24 @@ func (c *command) validateFlags() error {
 	return nil
 }
 
-const templateFolder = "/Users/i347365/go/src/github.com/nexus49/cli/resources/templates/lambda-javascript"
-
-// const outputFolder = "/Users/i347365/go/src/github.com/nexus49/cli/out"
+// runtimes are the runtimes with a template set in the embedded templates
+var runtimes = []string{"nodejs8", "nodejs12", "python3"}
 
-func createNewTemplate(name string, namespace string, expose bool, clusterDomain string, kube kube.KymaKube) error {
+func createNewTemplate(name string, o *Options) error {
 	params := TemplateParameters{
 		Name:          name,
-		Expose:        expose,
-		Namespace:     namespace,
-		ClusterDomain: clusterDomain,
+		Expose:        o.Expose,
+		Namespace:     o.Namespace,
+		ClusterDomain: o.ClusterDomain,
 	}
 
-	currentDir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+	fs, templateFolder, cleanup, err := templateSource(o.Template, o.Runtime)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	currentDir, err := os.Getwd()
 	if err != nil {
 		return err
 	}
@@ -131,20 +146,62 @@ func createNewTemplate(name string, namespace string, expose bool, clusterDomain
 	}
 
 	// iterate through files of template and create files based on parameters
-	var files []string
-	err = filepath.Walk(templateFolder, func(path string, info os.FileInfo, err error) error {
-		files = append(files, path)
-		return nil
+	err = fs.Walk(templateFolder, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if path == templateFolder {
+			return nil
+		}
+		return dev.ProcessTemplateFile(fs, outputPath, templateFolder, path, info, params)
 	})
 	if err != nil {
 		return errors.Wrap(err, "Could not generate lambda")
 	}
-	for _, file := range files {
-		if file != templateFolder {
-			dev.ProcessTemplateFile(outputPath, templateFolder, file, params)
+	return nil
+}
+
+// templateSource returns the file system and folder of the template set for the runtime.
+// Custom templates are a local directory or a git repository, which contain the template set directly or in a lambda-<runtime> folder.
+func templateSource(template, runtime string) (templates.FS, string, func(), error) {
+	noCleanup := func() {}
+	folder := "lambda-" + runtime
+	if template == "" {
+		return templates.Embedded, folder, noCleanup, nil
+	}
+
+	dir := template
+	cleanup := noCleanup
+	if isGitURL(template) {
+		var err error
+		if dir, err = ioutil.TempDir("", "kyma-lambda-template"); err != nil {
+			return nil, "", nil, errors.Wrap(err, "Cannot create temporary directory for the templates")
+		}
+		cleanup = func() { os.RemoveAll(dir) }
+		if _, err := git.PlainClone(dir, false, &git.CloneOptions{URL: template, Depth: 1}); err != nil {
+			cleanup()
+			return nil, "", nil, errors.Wrapf(err, "Cannot clone the templates from %s", template)
+		}
+	} else if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil, "", nil, fmt.Errorf("the template %s is neither a directory nor a git URL", template)
+	}
+
+	if info, err := os.Stat(filepath.Join(dir, folder)); err == nil && info.IsDir() {
+		return templates.Dir(dir), folder, cleanup, nil
+	}
+	return templates.Dir(dir), ".", cleanup, nil
+}
+
+func isGitURL(s string) bool {
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git@", "git://"} {
+		if strings.HasPrefix(s, prefix) {
+			return true
 		}
 	}
-	return nil
+	return strings.HasSuffix(s, ".git")
 }
 
 type TemplateParameters struct {
@@ -154,29 +211,13 @@ type TemplateParameters struct {
 	ClusterDomain string
 }
 
-func ProcessTemplateFile(outputpath string, path string, params TemplateParameters) error {
-	fi, err := os.Stat(path)
-	if err != nil {
-		return errors.Wrap(err, "could not find file")
-	}
-
-	switch mode := fi.Mode(); {
-	case mode.IsDir():
-		templatePath := path[len(templateFolder)+1:]
-		outputPath := filepath.Join(outputpath, templatePath)
-
-		err = dev.EnsureDir(outputPath)
-		if err != nil {
-			return err
+func runtimeSupported(runtime string) bool {
+	for _, r := range runtimes {
+		if r == runtime {
+			return true
 		}
-
-	case mode.IsRegular():
-		templatePath := path[len(templateFolder)+1 : len(path)-5]
-		outputPath := filepath.Join(outputpath, templatePath)
-		dev.CreateFromTemplate(path, outputPath, params)
 	}
-
-	return nil
+	return false
 }
 
 func getClusterDomainFromKubecofig(kubeconfigPath string) (*string, error) {
@@ -0,0 +1,65 @@
+package newLambda
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli/cmd/kyma/dev"
+	"github.com/kyma-project/cli/internal/templates"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNewTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "new-lambda")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	for _, runtime := range runtimes {
+		o := &Options{Namespace: "stage", Expose: true, ClusterDomain: "kyma.local", Runtime: runtime}
+		require.NoError(t, createNewTemplate("fn-"+runtime, o), runtime)
+
+		b, err := ioutil.ReadFile(filepath.Join(dir, "fn-"+runtime, "kyma.json"))
+		require.NoError(t, err, runtime)
+		config := &dev.Config{}
+		require.NoError(t, json.Unmarshal(b, config), runtime)
+		require.Equal(t, "fn-"+runtime, config.Name)
+		require.Equal(t, "stage", config.Namespace)
+		require.True(t, config.Expose)
+		require.NotEmpty(t, config.Runtime)
+		require.FileExists(t, filepath.Join(dir, "fn-"+runtime, config.File), "the function file of kyma.json must be created")
+	}
+}
+
+func TestTemplateSource(t *testing.T) {
+	fs, folder, _, err := templateSource("", "python3")
+	require.NoError(t, err)
+	require.Equal(t, templates.Embedded, fs)
+	require.Equal(t, "lambda-python3", folder)
+
+	dir, err := ioutil.TempDir("", "lambda-templates")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, folder, _, err = templateSource(dir, "nodejs12")
+	require.NoError(t, err)
+	require.Equal(t, ".", folder, "a directory without runtime folders is a single template set")
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "lambda-nodejs12"), 0755))
+	_, folder, _, err = templateSource(dir, "nodejs12")
+	require.NoError(t, err)
+	require.Equal(t, "lambda-nodejs12", folder)
+
+	_, _, _, err = templateSource(filepath.Join(dir, "missing"), "nodejs12")
+	require.Error(t, err)
+
+	require.True(t, isGitURL("https://github.com/my-company/lambda-templates"))
+	require.True(t, isGitURL("git@github.com:my-company/lambda-templates.git"))
+	require.False(t, isGitURL("./lambda-templates"))
+}
@@ -7,6 +7,8 @@ type Options struct {
 	Namespace     string
 	Expose        bool
 	ClusterDomain string
+	Runtime       string
+	Template      string
 }
 
 //NewOptions creates options with default values
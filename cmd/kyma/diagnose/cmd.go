@@ -0,0 +1,81 @@
+package diagnose
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kyma-project/cli/cmd/kyma/version"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/diagnose"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const defaultOutput = "kyma-diagnostics.tgz"
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new diagnose command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Collects diagnostic data of a Kyma cluster into an archive.",
+		Long: `Use this command to collect diagnostic data of a Kyma cluster when an installation or a test run fails.
+
+The command collects the following data into a single archive:
+- The ` + "`kyma-cluster-info`" + ` ConfigMap and the installed Kyma version.
+- The Installation CR, its error log, the Kyma Installer logs, and the installation overrides.
+- The states of all Pods, PersistentVolumeClaims, and events of the cluster.
+- The results of all test suites.
+
+Values of Secrets and of all other entries that look like credentials are redacted.
+The archive includes a summary which flags common failure signatures, such as Pods in ` + "`CrashLoopBackOff`" + ` or ` + "`ImagePullBackOff`" + `, pending PersistentVolumeClaims, and failing webhooks.
+`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Aliases: []string{"diag"},
+	}
+
+	cmd.Flags().StringVarP(&o.Output, "output", "o", defaultOutput, "Path to the archive in which the diagnostic data is stored.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	var err error
+	if c.K8s, err = kube.NewFromConfig("", c.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
+	}
+
+	s := c.NewStep("Collecting diagnostic data")
+	f, err := os.Create(c.opts.Output)
+	if err != nil {
+		s.Failure()
+		return errors.Wrapf(err, "Could not create the diagnostics archive '%s'", c.opts.Output)
+	}
+	defer f.Close()
+
+	bundle := diagnose.NewBundle(f)
+	kymaVersion := func() (string, error) { return version.KymaVersion(c.opts.Verbose, c.K8s) }
+	report, err := diagnose.NewCollector(c.K8s, kymaVersion).Collect(c.Context(), bundle)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	if err := bundle.Close(); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Diagnostic data written to '%s'", c.opts.Output)
+
+	fmt.Println()
+	return report.Write(os.Stdout)
+}
@@ -0,0 +1,31 @@
+package diagnose
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiagnoseFlags ensures that the provided command flags are stored in the options.
+func TestDiagnoseFlags(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+
+	// test default flag values
+	require.Equal(t, "kyma-diagnostics.tgz", o.Output, "Default value for the output flag not as expected.")
+
+	// test passing flags
+	err := c.ParseFlags([]string{"-o", "/tmp/bundle.tgz"})
+	require.NoError(t, err, "Parsing flags should not return an error")
+	require.Equal(t, "/tmp/bundle.tgz", o.Output, "The parsed value for the output flag not as expected.")
+}
+
+func TestDiagnoseSubcommands(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+
+	sub := c.Commands()
+
+	require.Equal(t, 0, len(sub), "Number of diagnose subcommands not as expected")
+}
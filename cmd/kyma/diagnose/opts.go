@@ -0,0 +1,14 @@
+package diagnose
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the diagnose command
+type Options struct {
+	*cli.Options
+	Output string
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
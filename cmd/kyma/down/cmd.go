@@ -0,0 +1,53 @@
+package down
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/cmd/kyma/deprovision/cluster"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new down command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Removes a cluster created with kyma up.",
+		Long:  `Use this command to reverse ` + "`kyma up`" + `. It deprovisions the cluster defined in the cluster file, removes its kubeconfig entries, and removes the stored cluster state including the progress of ` + "`kyma up`" + `.`,
+		RunE:  func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().StringVarP(&o.File, "file", "f", "", "Path to the cluster file the cluster was created with. (required)")
+	return cmd
+}
+
+func (c *command) Run() error {
+	if c.opts.File == "" {
+		return errors.New("Required flag `file` has not been set.")
+	}
+	f, err := clusters.LoadFile(c.opts.File)
+	if err != nil {
+		return err
+	}
+	if f.Name == "" {
+		return fmt.Errorf("the cluster file %s must define the name of the cluster", c.opts.File)
+	}
+
+	o := cluster.NewOptions(c.opts.Options, f.Provider)
+	o.Name = f.Name
+	o.Project = f.Project
+	o.CredentialsFile = f.Credentials
+	return cluster.Run(o)
+}
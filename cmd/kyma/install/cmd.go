@@ -40,6 +40,7 @@ type clusterInfo struct {
 	profile       string
 	localIP       string
 	localVMDriver string
+	preset        string
 }
 
 //NewCmd creates a new kyma command
@@ -108,6 +109,7 @@ The standard installation uses the minimal configuration. The system performs th
 	cobraCmd.Flags().StringVarP(&o.Password, "password", "p", "", "Predefined cluster password.")
 	cobraCmd.Flags().StringArrayVarP(&o.OverrideConfigs, "override", "o", nil, "Path to a YAML file with parameters to override.")
 	cobraCmd.Flags().IntVar(&o.FallbackLevel, "fallbackLevel", 5, `If "source=latest-published", defines the number of commits from master branch taken into account if artifacts for newer commits do not exist yet`)
+	cobraCmd.Flags().StringSliceVar(&o.Stores, "store", []string{string(trust.SystemStore)}, "Stores into which the Kyma root certificate is imported after the installation. Takes a comma-separated list of: "+strings.Join(trust.StoreNames(), ", ")+".")
 	return cobraCmd
 }
 
@@ -117,7 +119,11 @@ func (cmd *command) Run() error {
 		cmd.Factory.NonInteractive = true
 	}
 
-	var err error
+	stores, err := trust.ParseStores(cmd.opts.Stores)
+	if err != nil {
+		return err
+	}
+
 	if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
 	}
@@ -131,13 +137,13 @@ func (cmd *command) Run() error {
 	s.Successf("Cluster info read")
 
 	installation := cmd.configureInstallation(clusterConfig)
-	result, err := installation.InstallKyma()
+	result, err := installation.InstallKyma(cmd.Context())
 	if err != nil {
 		return err
 	}
 
 	if !cmd.opts.CI {
-		if err := cmd.importCertificate(trust.NewCertifier(cmd.K8s)); err != nil {
+		if err := cmd.importCertificate(trust.NewCertifier(cmd.K8s, stores...)); err != nil {
 			// certificate import errors do not mean installation failed
 			cmd.CurrentStep.LogError(err.Error())
 		}
@@ -145,7 +151,7 @@ func (cmd *command) Run() error {
 
 	if clusterConfig.isLocal {
 		s = cmd.NewStep("Adding domains to /etc/hosts")
-		err = cmd.addDevDomainsToEtcHosts(s, clusterConfig)
+		err = AddDevDomainsToEtcHosts(s, cmd.K8s, clusterConfig.localCluster(), cmd.opts.Domain, cmd.opts.Verbose)
 		if err != nil {
 			s.Failure()
 			return err
@@ -179,12 +185,8 @@ func (cmd *command) configureInstallation(clusterConfig clusterInfo) *installati
 			Source:          cmd.opts.Source,
 			FallbackLevel:   cmd.opts.FallbackLevel,
 			IsLocal:         clusterConfig.isLocal,
-			LocalCluster: &installation.LocalCluster{
-				IP:       clusterConfig.localIP,
-				Profile:  clusterConfig.profile,
-				Provider: clusterConfig.provider,
-				VMDriver: clusterConfig.localVMDriver,
-			},
+			LocalCluster:    clusterConfig.localCluster(),
+			Preset:          clusterConfig.preset,
 		},
 	}
 }
@@ -221,10 +223,11 @@ func (cmd *command) importCertificate(ca trust.Certifier) error {
 	return nil
 }
 
-func (cmd *command) addDevDomainsToEtcHosts(s step.Step, clusterInfo clusterInfo) error {
+//AddDevDomainsToEtcHosts maps the hosts of the Kyma virtual services to the local cluster, inside the Minikube VM and in the hosts file of this machine
+func AddDevDomainsToEtcHosts(s step.Step, k8s kube.KymaKube, cluster *installation.LocalCluster, domain string, verbose bool) error {
 	hostnames := ""
 
-	vsList, err := cmd.K8s.Istio().NetworkingV1alpha3().VirtualServices("").List(metav1.ListOptions{})
+	vsList, err := k8s.Istio().NetworkingV1alpha3().VirtualServices("").List(metav1.ListOptions{})
 	if err != nil {
 		return err
 	}
@@ -237,16 +240,26 @@ func (cmd *command) addDevDomainsToEtcHosts(s step.Step, clusterInfo clusterInfo
 
 	hostAlias := "127.0.0.1" + hostnames
 
-	if clusterInfo.localVMDriver != "none" {
-		_, err := minikube.RunCmd(cmd.opts.Verbose, clusterInfo.profile, "ssh", "sudo /bin/sh -c 'echo \""+hostAlias+"\" >> /etc/hosts'")
+	// the none driver has no node of its own, the hosts file of this machine is updated below
+	if cluster.VMDriver != minikube.DriverNone {
+		_, err := minikube.RunCmd(verbose, cluster.Profile, "ssh", "sudo /bin/sh -c 'echo \""+hostAlias+"\" >> /etc/hosts'")
 		if err != nil {
 			return err
 		}
 	}
 
-	hostAlias = strings.Trim(clusterInfo.localIP, "\n") + hostnames
+	hostAlias = strings.Trim(cluster.IP, "\n") + hostnames
 
-	return addDevDomainsToEtcHostsOSSpecific(cmd.opts.Domain, s, hostAlias)
+	return addDevDomainsToEtcHostsOSSpecific(domain, s, hostAlias)
+}
+
+func (i clusterInfo) localCluster() *installation.LocalCluster {
+	return &installation.LocalCluster{
+		IP:       i.localIP,
+		Profile:  i.profile,
+		Provider: i.provider,
+		VMDriver: i.localVMDriver,
+	}
 }
 
 func (cmd *command) getClusterInfoFromConfigMap() (clusterInfo, error) {
@@ -269,6 +282,7 @@ func (cmd *command) getClusterInfoFromConfigMap() (clusterInfo, error) {
 		profile:       cm.Data["profile"],
 		localIP:       cm.Data["localIP"],
 		localVMDriver: cm.Data["localVMDriver"],
+		preset:        cm.Data["preset"],
 	}
 
 	return clusterConfig, nil
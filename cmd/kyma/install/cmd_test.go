@@ -88,3 +88,18 @@ func TestImportCertificate(t *testing.T) {
 	}
 
 }
+
+func TestInstallStoreFlag(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+
+	require.Equal(t, []string{"system"}, o.Stores, "Default value for the store flag not as expected.")
+
+	require.NoError(t, c.ParseFlags([]string{"--store", "user,nss"}))
+	require.Equal(t, []string{"user", "nss"}, o.Stores, "The parsed value for the store flag not as expected.")
+
+	require.NoError(t, c.ParseFlags([]string{"--store", "unknown"}))
+	err := c.RunE(c, nil)
+	require.Error(t, err, "Unknown stores must be rejected before the installation starts.")
+	require.Contains(t, err.Error(), "Unknown certificate store 'unknown'")
+}
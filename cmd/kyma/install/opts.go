@@ -22,6 +22,7 @@ type Options struct {
 	OverrideConfigs []string
 	Source          string
 	FallbackLevel   int
+	Stores          []string
 }
 
 //NewOptions creates options with default values
@@ -7,25 +7,57 @@ import (
 	"github.com/kyma-project/cli/cmd/kyma/connectivity/createApplication"
 	"github.com/kyma-project/cli/cmd/kyma/connectivity/createToken"
 	"github.com/kyma-project/cli/cmd/kyma/console"
+	"github.com/kyma-project/cli/cmd/kyma/deprovision"
+	deprovisionCluster "github.com/kyma-project/cli/cmd/kyma/deprovision/cluster"
+	deprovisionMinikube "github.com/kyma-project/cli/cmd/kyma/deprovision/minikube"
 	"github.com/kyma-project/cli/cmd/kyma/dev"
 	devDebug "github.com/kyma-project/cli/cmd/kyma/dev/debug"
 	devDeploy "github.com/kyma-project/cli/cmd/kyma/dev/deploy"
 	devNewLambda "github.com/kyma-project/cli/cmd/kyma/dev/newLambda"
+	"github.com/kyma-project/cli/cmd/kyma/diagnose"
+	"github.com/kyma-project/cli/cmd/kyma/down"
 	"github.com/kyma-project/cli/cmd/kyma/install"
+	kymaMinikube "github.com/kyma-project/cli/cmd/kyma/minikube"
+	minikubeDelete "github.com/kyma-project/cli/cmd/kyma/minikube/delete"
+	minikubeRestore "github.com/kyma-project/cli/cmd/kyma/minikube/restore"
+	minikubeSnapshot "github.com/kyma-project/cli/cmd/kyma/minikube/snapshot"
+	minikubeStart "github.com/kyma-project/cli/cmd/kyma/minikube/start"
+	minikubeStop "github.com/kyma-project/cli/cmd/kyma/minikube/stop"
+	"github.com/kyma-project/cli/cmd/kyma/provision/aws"
 	"github.com/kyma-project/cli/cmd/kyma/provision/azure"
 	"github.com/kyma-project/cli/cmd/kyma/provision/gardener"
 	"github.com/kyma-project/cli/cmd/kyma/provision/gcp"
+	provisionList "github.com/kyma-project/cli/cmd/kyma/provision/list"
 	"github.com/kyma-project/cli/cmd/kyma/provision/minikube"
+	"github.com/kyma-project/cli/cmd/kyma/provision/nodepool"
+	nodepoolAdd "github.com/kyma-project/cli/cmd/kyma/provision/nodepool/add"
+	nodepoolRemove "github.com/kyma-project/cli/cmd/kyma/provision/nodepool/remove"
+	"github.com/kyma-project/cli/cmd/kyma/provision/scale"
+	provisionStatus "github.com/kyma-project/cli/cmd/kyma/provision/status"
 	"github.com/kyma-project/cli/cmd/kyma/test"
 	"github.com/kyma-project/cli/cmd/kyma/test/definitions"
 	del "github.com/kyma-project/cli/cmd/kyma/test/delete"
+	testDiff "github.com/kyma-project/cli/cmd/kyma/test/diff"
+	"github.com/kyma-project/cli/cmd/kyma/test/history"
 	"github.com/kyma-project/cli/cmd/kyma/test/list"
 	"github.com/kyma-project/cli/cmd/kyma/test/logs"
 	"github.com/kyma-project/cli/cmd/kyma/test/run"
+	"github.com/kyma-project/cli/cmd/kyma/test/schedule"
+	scheduleCreate "github.com/kyma-project/cli/cmd/kyma/test/schedule/create"
+	scheduleDelete "github.com/kyma-project/cli/cmd/kyma/test/schedule/delete"
+	scheduleHistory "github.com/kyma-project/cli/cmd/kyma/test/schedule/history"
+	scheduleList "github.com/kyma-project/cli/cmd/kyma/test/schedule/list"
 	"github.com/kyma-project/cli/cmd/kyma/test/status"
+	"github.com/kyma-project/cli/cmd/kyma/trust"
+	trustExport "github.com/kyma-project/cli/cmd/kyma/trust/export"
+	trustImport "github.com/kyma-project/cli/cmd/kyma/trust/importcert"
+	trustList "github.com/kyma-project/cli/cmd/kyma/trust/list"
+	trustRemove "github.com/kyma-project/cli/cmd/kyma/trust/remove"
+	"github.com/kyma-project/cli/cmd/kyma/up"
 	"github.com/kyma-project/cli/cmd/kyma/version"
 
 	"github.com/kyma-project/cli/cmd/kyma/provision"
+	"github.com/kyma-incubator/hydroform/provision/types"
 	"github.com/kyma-project/cli/internal/cli"
 	"github.com/spf13/cobra"
 )
@@ -57,6 +89,30 @@ For more information, see: https://github.com/kyma-project/cli
 	provisionCmd.AddCommand(gcp.NewCmd(gcp.NewOptions(o)))
 	provisionCmd.AddCommand(gardener.NewCmd(gardener.NewOptions(o)))
 	provisionCmd.AddCommand(azure.NewCmd(azure.NewOptions(o)))
+	provisionCmd.AddCommand(aws.NewCmd(aws.NewOptions(o)))
+	provisionCmd.AddCommand(provisionList.NewCmd(provisionList.NewOptions(o)))
+	provisionCmd.AddCommand(provisionStatus.NewCmd(provisionStatus.NewOptions(o)))
+	provisionCmd.AddCommand(scale.NewCmd(scale.NewOptions(o)))
+	nodepoolCmd := nodepool.NewCmd()
+	nodepoolCmd.AddCommand(nodepoolAdd.NewCmd(nodepoolAdd.NewOptions(o)))
+	nodepoolCmd.AddCommand(nodepoolRemove.NewCmd(nodepoolRemove.NewOptions(o)))
+	provisionCmd.AddCommand(nodepoolCmd)
+
+	deprovisionCmd := deprovision.NewCmd()
+	deprovisionCmd.AddCommand(deprovisionMinikube.NewCmd(deprovisionMinikube.NewOptions(o)))
+	deprovisionCmd.AddCommand(deprovisionCluster.NewCmd(deprovisionCluster.NewOptions(o, types.GCP)))
+	deprovisionCmd.AddCommand(deprovisionCluster.NewCmd(deprovisionCluster.NewOptions(o, types.Gardener)))
+	deprovisionCmd.AddCommand(deprovisionCluster.NewCmd(deprovisionCluster.NewOptions(o, types.Azure)))
+	deprovisionCmd.AddCommand(deprovisionCluster.NewCmd(deprovisionCluster.NewOptions(o, types.AWS)))
+
+	minikubeCmd := kymaMinikube.NewCmd()
+	minikubeCmd.AddCommand(
+		minikubeStop.NewCmd(minikubeStop.NewOptions(o)),
+		minikubeStart.NewCmd(minikubeStart.NewOptions(o)),
+		minikubeDelete.NewCmd(minikubeDelete.NewOptions(o)),
+		minikubeSnapshot.NewCmd(minikubeSnapshot.NewOptions(o)),
+		minikubeRestore.NewCmd(minikubeRestore.NewOptions(o)),
+	)
 
 	connectivityCmd := connectivity.NewCmd()
 	connectivityCmd.AddCommand(createApplication.NewCmd(createApplication.NewOptions(o)))
@@ -73,9 +129,14 @@ For more information, see: https://github.com/kyma-project/cli
 		completion.NewCmd(),
 		install.NewCmd(install.NewOptions(o)),
 		provisionCmd,
+		deprovisionCmd,
+		up.NewCmd(up.NewOptions(o)),
+		down.NewCmd(down.NewOptions(o)),
+		minikubeCmd,
 		console.NewCmd(console.NewOptions(o)),
 		connectivityCmd,
 		devCmd,
+		diagnose.NewCmd(diagnose.NewOptions(o)),
 	)
 
 	testCmd := test.NewCmd()
@@ -85,8 +146,26 @@ For more information, see: https://github.com/kyma-project/cli
 	testListCmd := list.NewCmd(list.NewOptions(o))
 	testDefsCmd := definitions.NewCmd(definitions.NewOptions(o))
 	testLogsCmd := logs.NewCmd(logs.NewOptions(o))
-	testCmd.AddCommand(testRunCmd, testStatusCmd, testDeleteCmd, testListCmd, testDefsCmd, testLogsCmd)
+	testHistoryCmd := history.NewCmd(history.NewOptions(o))
+	testDiffCmd := testDiff.NewCmd(testDiff.NewOptions(o))
+	testScheduleCmd := schedule.NewCmd()
+	testScheduleCmd.AddCommand(
+		scheduleCreate.NewCmd(scheduleCreate.NewOptions(o)),
+		scheduleList.NewCmd(scheduleList.NewOptions(o)),
+		scheduleDelete.NewCmd(scheduleDelete.NewOptions(o)),
+		scheduleHistory.NewCmd(scheduleHistory.NewOptions(o)),
+	)
+	testCmd.AddCommand(testRunCmd, testStatusCmd, testDeleteCmd, testListCmd, testDefsCmd, testLogsCmd, testHistoryCmd, testDiffCmd, testScheduleCmd)
 	cmd.AddCommand(testCmd)
 
+	trustCmd := trust.NewCmd()
+	trustCmd.AddCommand(
+		trustImport.NewCmd(trustImport.NewOptions(o)),
+		trustList.NewCmd(trustList.NewOptions(o)),
+		trustRemove.NewCmd(trustRemove.NewOptions(o)),
+		trustExport.NewCmd(trustExport.NewOptions(o)),
+	)
+	cmd.AddCommand(trustCmd)
+
 	return cmd
 }
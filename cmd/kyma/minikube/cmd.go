@@ -0,0 +1,19 @@
+package minikube
+
+import (
+	"github.com/spf13/cobra"
+)
+
+//NewCmd creates a new minikube command
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minikube",
+		Short: "Stops, starts, snapshots, and deletes a Minikube cluster with Kyma.",
+		Long: `Use the subcommands to manage the lifecycle of a Minikube cluster which you provisioned with the ` + "`kyma provision minikube`" + ` command.
+
+Minikube may assign a new IP to the cluster when it starts again, which leaves the IP in the cluster info, the Kyma overrides, and the hosts file stale. Use ` + "`kyma minikube start`" + ` instead of ` + "`minikube start`" + ` to update them.
+
+Use ` + "`kyma minikube snapshot`" + ` to save the VM of the cluster, for example after installing Kyma, and ` + "`kyma minikube restore`" + ` to go back to it. A restored cluster may come up with a different IP, so run ` + "`kyma minikube start`" + ` after restoring.`,
+	}
+	return cmd
+}
@@ -0,0 +1,38 @@
+package delete
+
+import (
+	deprovision "github.com/kyma-project/cli/cmd/kyma/deprovision/minikube"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new delete command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes the Minikube cluster.",
+		Long: `Use this command to delete the Minikube cluster together with Kyma and all resources on it. It is the same as ` + "`kyma deprovision minikube`" + `.
+The Kyma domains stay in the hosts file of this machine, and the next installation on Minikube replaces them.`,
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "Specifies the Minikube profile.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	o := deprovision.NewOptions(c.opts.Options)
+	o.Name = c.opts.Profile
+	return deprovision.Run(o)
+}
@@ -0,0 +1,64 @@
+package restore
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/minikube"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new restore command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore NAME",
+		Short: "Restores a snapshot of the Minikube cluster.",
+		Long: `Use this command to replace the Minikube VM with a snapshot which you saved with ` + "`kyma minikube snapshot`" + `.
+The command stops Minikube and discards all changes made to the cluster since the snapshot. To continue working with the cluster, run ` + "`kyma minikube start`" + `, which also updates the IP of the cluster if it changed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error { return c.Run(args[0]) },
+	}
+
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "Specifies the Minikube profile.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run(name string) error {
+	machineDir, err := minikube.MachineDir(c.opts.Profile)
+	if err != nil {
+		return err
+	}
+	kymaHome, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+
+	s := c.NewStep("Stopping Minikube")
+	if _, err := minikube.RunCmd(c.opts.Verbose, c.opts.Profile, "stop"); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Minikube stopped")
+
+	s = c.NewStep(fmt.Sprintf("Restoring the snapshot '%s'", name))
+	if err := minikube.RestoreSnapshot(machineDir, minikube.SnapshotDir(kymaHome, c.opts.Profile), name); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Snapshot '%s' restored", name)
+
+	fmt.Println("\nRun `kyma minikube start` to start the cluster.")
+	return nil
+}
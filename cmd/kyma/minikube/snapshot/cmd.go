@@ -0,0 +1,65 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/minikube"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new snapshot command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "snapshot NAME",
+		Short: "Saves a snapshot of the Minikube cluster.",
+		Long: `Use this command to save a snapshot of the Minikube VM, for example right after installing Kyma.
+The command stops Minikube and copies the VM into the Kyma CLI home folder. To go back to the snapshot, run ` + "`kyma minikube restore`" + `.
+Snapshots are only supported with drivers which run the cluster in a VM.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error { return c.Run(args[0]) },
+	}
+
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "Specifies the Minikube profile.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run(name string) error {
+	machineDir, err := minikube.MachineDir(c.opts.Profile)
+	if err != nil {
+		return err
+	}
+	kymaHome, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+
+	s := c.NewStep("Stopping Minikube")
+	if _, err := minikube.RunCmd(c.opts.Verbose, c.opts.Profile, "stop"); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Minikube stopped")
+
+	s = c.NewStep(fmt.Sprintf("Saving the snapshot '%s'", name))
+	if err := minikube.SaveSnapshot(machineDir, minikube.SnapshotDir(kymaHome, c.opts.Profile), name); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Snapshot '%s' saved", name)
+
+	fmt.Println("\nRun `kyma minikube start` to start the cluster again.")
+	return nil
+}
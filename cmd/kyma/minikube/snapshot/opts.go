@@ -0,0 +1,15 @@
+package snapshot
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the minikube snapshot command
+type Options struct {
+	*cli.Options
+
+	Profile string
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
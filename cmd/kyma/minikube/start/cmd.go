@@ -0,0 +1,107 @@
+package start
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/avast/retry-go"
+	"github.com/kyma-project/cli/cmd/kyma/install"
+	provisionMinikube "github.com/kyma-project/cli/cmd/kyma/provision/minikube"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/minikube"
+	"github.com/kyma-project/cli/pkg/installation"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const defaultDomain = "kyma.local"
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new start command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Starts a stopped Minikube cluster and updates its IP for Kyma.",
+		Long: `Use this command to start a Minikube cluster which you stopped with ` + "`kyma minikube stop`" + ` or ` + "`minikube stop`" + `.
+After Minikube is up, the command detects its IP and updates the ` + "`kyma-cluster-info`" + ` ConfigMap, the ` + "`installation-config-overrides`" + ` ConfigMap, and the Kyma domains in the hosts files of Minikube and of this machine.`,
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "Specifies the Minikube profile.")
+	cmd.Flags().StringVarP(&o.Domain, "domain", "d", "", "Domain of the Kyma installation. Defaults to the domain stored in the installation overrides, or to "+defaultDomain+" if they have none.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	s := c.NewStep("Starting Minikube")
+	if _, err := minikube.RunCmd(c.opts.Verbose, c.opts.Profile, append([]string{"start"}, provisionMinikube.KubernetesArgs()...)...); err != nil {
+		s.Failure()
+		return err
+	}
+	out, err := minikube.RunCmd(c.opts.Verbose, c.opts.Profile, "ip")
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	ip := strings.TrimSpace(out)
+	s.Successf("Minikube started with IP %s", ip)
+
+	if c.K8s, err = kube.NewFromConfig("", c.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
+	}
+
+	s = c.NewStep("Updating the IP of the cluster")
+	var cluster *installation.LocalCluster
+	var previousIP string
+	var domain string
+	var installed bool
+	// the API server may not accept requests right after Minikube started
+	err = retry.Do(func() error {
+		if cluster, previousIP, err = updateClusterInfo(c.K8s.Static(), ip); err != nil {
+			return err
+		}
+		domain, installed, err = updateOverrides(c.K8s.Static(), ip)
+		return err
+	}, retry.Attempts(5))
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	if previousIP == ip {
+		s.Successf("The IP of the cluster did not change")
+	} else {
+		s.Successf("The IP of the cluster changed from %s to %s", previousIP, ip)
+	}
+	if c.opts.Profile != "" {
+		cluster.Profile = c.opts.Profile
+	}
+
+	if c.opts.Domain != "" {
+		domain = c.opts.Domain
+	} else if domain == "" {
+		domain = defaultDomain
+	}
+
+	if installed {
+		s = c.NewStep("Adding domains to /etc/hosts")
+		if err := install.AddDevDomainsToEtcHosts(s, c.K8s, cluster, domain, c.opts.Verbose); err != nil {
+			s.Failure()
+			return err
+		}
+		s.Successf("Domains added")
+	}
+
+	fmt.Println("\nMinikube is up and running. Happy Minikube-ing! :)")
+	return nil
+}
@@ -0,0 +1,67 @@
+package start
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/pkg/installation"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	clusterInfoName = "kyma-cluster-info"
+	overridesName   = "installation-config-overrides"
+	minikubeIPKey   = "global.minikubeIP"
+	domainKey       = "global.domainName"
+)
+
+// updateClusterInfo writes the IP into the cluster info ConfigMap and returns the local cluster described by it and the previous IP
+func updateClusterInfo(k8s kubernetes.Interface, ip string) (*installation.LocalCluster, string, error) {
+	cm, err := k8s.CoreV1().ConfigMaps("kube-system").Get(clusterInfoName, metav1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil, "", fmt.Errorf("the cluster has no %s ConfigMap, make sure it was provisioned with `kyma provision minikube`", clusterInfoName)
+		}
+		return nil, "", err
+	}
+
+	previousIP := cm.Data["localIP"]
+	if previousIP != ip {
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data["localIP"] = ip
+		if _, err := k8s.CoreV1().ConfigMaps("kube-system").Update(cm); err != nil {
+			return nil, "", err
+		}
+	}
+	return &installation.LocalCluster{
+		IP:       ip,
+		Profile:  cm.Data["profile"],
+		Provider: cm.Data["provider"],
+		VMDriver: cm.Data["localVMDriver"],
+	}, previousIP, nil
+}
+
+// updateOverrides writes the IP into the Kyma installation overrides and returns the domain of the installation, it returns false if Kyma is not installed
+func updateOverrides(k8s kubernetes.Interface, ip string) (string, bool, error) {
+	cm, err := k8s.CoreV1().ConfigMaps("kyma-installer").Get(overridesName, metav1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	domain := cm.Data[domainKey]
+	if cm.Data[minikubeIPKey] == ip {
+		return domain, true, nil
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[minikubeIPKey] = ip
+	_, err = k8s.CoreV1().ConfigMaps("kyma-installer").Update(cm)
+	return domain, true, err
+}
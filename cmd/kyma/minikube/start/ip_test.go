@@ -0,0 +1,51 @@
+package start
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateIP(t *testing.T) {
+	k8s := fake.NewSimpleClientset()
+
+	_, _, err := updateClusterInfo(k8s, "192.168.64.5")
+	require.Error(t, err, "clusters without cluster info were not provisioned by the Kyma CLI")
+	_, installed, err := updateOverrides(k8s, "192.168.64.5")
+	require.NoError(t, err)
+	require.False(t, installed, "the overrides only exist when Kyma is installed")
+
+	_, err = k8s.CoreV1().ConfigMaps("kube-system").Create(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterInfoName},
+		Data:       map[string]string{"profile": "kyma", "localIP": "192.168.64.4", "localVMDriver": "hyperkit"},
+	})
+	require.NoError(t, err)
+	_, err = k8s.CoreV1().ConfigMaps("kyma-installer").Create(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: overridesName},
+		Data:       map[string]string{minikubeIPKey: "192.168.64.4", domainKey: "kyma.example.com", "global.adminPassword": "secret"},
+	})
+	require.NoError(t, err)
+
+	cluster, previousIP, err := updateClusterInfo(k8s, "192.168.64.5")
+	require.NoError(t, err)
+	require.Equal(t, "192.168.64.4", previousIP)
+	require.Equal(t, "192.168.64.5", cluster.IP)
+	require.Equal(t, "kyma", cluster.Profile)
+	require.Equal(t, "hyperkit", cluster.VMDriver)
+
+	domain, installed, err := updateOverrides(k8s, "192.168.64.5")
+	require.NoError(t, err)
+	require.True(t, installed)
+	require.Equal(t, "kyma.example.com", domain, "the domain of the installation must be returned")
+
+	info, err := k8s.CoreV1().ConfigMaps("kube-system").Get(clusterInfoName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "192.168.64.5", info.Data["localIP"])
+	overrides, err := k8s.CoreV1().ConfigMaps("kyma-installer").Get(overridesName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "192.168.64.5", overrides.Data[minikubeIPKey])
+	require.Equal(t, "secret", overrides.Data["global.adminPassword"], "other overrides must be kept")
+}
@@ -0,0 +1,16 @@
+package start
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the minikube start command
+type Options struct {
+	*cli.Options
+
+	Profile string
+	Domain  string
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
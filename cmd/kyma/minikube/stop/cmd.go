@@ -0,0 +1,46 @@
+package stop
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/minikube"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new stop command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stops the Minikube cluster.",
+		Long: `Use this command to stop the Minikube cluster while keeping Kyma and all resources on it.
+To continue working with the cluster, run ` + "`kyma minikube start`" + `.`,
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "Specifies the Minikube profile.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	s := c.NewStep("Stopping Minikube")
+	if _, err := minikube.RunCmd(c.opts.Verbose, c.opts.Profile, "stop"); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Minikube stopped")
+
+	fmt.Println("\nRun `kyma minikube start` to start the cluster again.")
+	return nil
+}
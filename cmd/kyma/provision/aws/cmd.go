@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/avast/retry-go"
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/eksctl"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/pricing"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new aws command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "aws",
+		Short: "Provisions an Amazon Elastic Kubernetes Service (EKS) cluster on AWS.",
+		Long: `Use this command to provision a Kubernetes cluster on AWS for Kyma installation. Use the flags to specify cluster details.
+The cluster is stored in the Kyma CLI home directory under the AWS profile as its project, so that it shows up in ` + "`kyma provision list`" + ` and can be removed with ` + "`kyma deprovision aws`" + `.
+Scaling and node pools are not supported for AWS clusters, manage their node groups with eksctl instead.
+NOTE: The cluster is provisioned with eksctl, make sure it is installed. The AWS credentials are read from the standard AWS profile files, to configure them run ` + "`aws configure`" + `.`,
+
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the EKS cluster to provision. (required)")
+	cmd.Flags().StringVar(&o.Profile, "profile", "", "AWS profile from the AWS credentials file to use. By default, the AWS_PROFILE environment variable or the default profile is used.")
+	cmd.Flags().StringVarP(&o.KubernetesVersion, "kube-version", "k", "1.15", "Kubernetes version of the cluster.")
+	cmd.Flags().StringVarP(&o.Region, "region", "r", "eu-central-1", "Region of the cluster.")
+	cmd.Flags().StringSliceVarP(&o.Zones, "zones", "z", nil, `Availability zones of the cluster, for example --zones="eu-central-1a,eu-central-1b". By default, eksctl selects the zones.`)
+	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "m5.xlarge", "Instance type used for the cluster nodes.")
+	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Disk size (in GB) of the cluster nodes.")
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, "Number of cluster nodes.")
+	cmd.Flags().StringVar(&o.VPCCIDR, "vpc-cidr", "", "CIDR of the VPC created for the cluster. By default, eksctl uses 192.168.0.0/16.")
+	cmd.Flags().StringSliceVar(&o.PrivateSubnets, "private-subnets", nil, "IDs of existing private subnets to use instead of creating a VPC.")
+	cmd.Flags().StringSliceVar(&o.PublicSubnets, "public-subnets", nil, "IDs of existing public subnets to use instead of creating a VPC.")
+	cmd.Flags().DurationVar(&o.TTL, "ttl", 0, "Time to live of the cluster, for example 72h. Clusters living longer are flagged as overdue in the list of provisioned clusters.")
+	cmd.Flags().Float64Var(&o.CostThreshold, "cost-threshold", 500, "Estimated monthly cost (in USD) above which you must confirm the provisioning. Set it to 0 to disable the confirmation.")
+	cmd.Flags().Float64Var(&o.MaxCost, "max-cost", 0, "Maximum estimated monthly cost (in USD) of the cluster. If the estimate is higher or unknown, the command fails, for example in CI pipelines. By default, there is no maximum.")
+
+	return cmd
+}
+
+func (c *command) Run() error {
+	if err := c.validateFlags(); err != nil {
+		return err
+	}
+	if err := eksctl.CheckInstalled(); err != nil {
+		return err
+	}
+
+	cluster := newCluster(c.opts)
+	s := c.NewStep("Estimating cost")
+	if err := pricing.Confirm(s, string(types.AWS), cluster, c.opts.costLimits(), c.opts.NonInteractive); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+
+	s = c.NewStep("Provisioning AWS EKS cluster")
+	s.Status("This takes about 20 minutes")
+	err := retry.Do(
+		func() error {
+			_, err := eksctl.RunCmd(c.Context(), c.opts.Verbose, c.opts.Profile, createArgs(c.opts)...)
+			return err
+		},
+		retry.Attempts(3),
+		// eksctl can not resume a creation which failed half-way, running it again only fails on the existing stack
+		retry.RetryIf(func(err error) bool {
+			return c.Context().Err() == nil && !strings.Contains(err.Error(), "already exists")
+		}))
+
+	if err != nil {
+		s.Failure()
+		if c.Interrupted() {
+			return errors.Wrapf(err, "Provisioning interrupted. The AWS EKS cluster '%s' may be left half-provisioned, check the CloudFormation stacks in the region '%s' for leftover resources and remove them", c.opts.Name, c.opts.Region)
+		}
+		return err
+	}
+	s.Success()
+
+	home, err := files.KymaHome()
+	if err == nil {
+		err = clusters.SaveEKS(home, c.opts.Profile, cluster, clusters.NewMetadata(c.opts.TTL))
+	}
+	if err != nil {
+		s.LogErrorf("Could not save the cluster, it will not be listed and must be deprovisioned with eksctl: %s", err)
+	}
+
+	s = c.NewStep("Importing kubeconfig")
+	kubeconfig, err := eksctl.Kubeconfig(c.Context(), c.opts.Verbose, c.opts.Profile, c.opts.Name, c.opts.Region)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+
+	if err := kube.AppendConfig(kubeconfig, c.opts.KubeconfigPath); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+
+	fmt.Printf("\nAWS EKS cluster installed\nKubectl correctly configured: pointing to %s\n\nHappy AWS-ing! :)\n", c.opts.Name)
+	return nil
+}
+
+// newCluster returns the specification of the cluster for the cost estimate and the list of provisioned clusters
+func newCluster(o *Options) *types.Cluster {
+	return &types.Cluster{
+		Name:              o.Name,
+		KubernetesVersion: o.KubernetesVersion,
+		Location:          o.Region,
+		MachineType:       o.MachineType,
+		NodeCount:         o.NodeCount,
+		DiskSizeGB:        o.DiskSizeGB,
+	}
+}
+
+// createArgs returns the arguments of eksctl to create the cluster defined by the options
+func createArgs(o *Options) []string {
+	args := []string{
+		"create", "cluster",
+		"--name", o.Name,
+		"--region", o.Region,
+		"--version", o.KubernetesVersion,
+		"--node-type", o.MachineType,
+		"--nodes", strconv.Itoa(o.NodeCount),
+		"--node-volume-size", strconv.Itoa(o.DiskSizeGB),
+		// the kubeconfig is imported separately to respect the kubeconfig flag of the CLI
+		"--write-kubeconfig=false",
+	}
+	if len(o.Zones) > 0 {
+		args = append(args, "--zones", strings.Join(o.Zones, ","))
+	}
+	if o.VPCCIDR != "" {
+		args = append(args, "--vpc-cidr", o.VPCCIDR)
+	}
+	if len(o.PrivateSubnets) > 0 {
+		args = append(args, "--vpc-private-subnets", strings.Join(o.PrivateSubnets, ","))
+	}
+	if len(o.PublicSubnets) > 0 {
+		args = append(args, "--vpc-public-subnets", strings.Join(o.PublicSubnets, ","))
+	}
+	return args
+}
+
+func (c *command) validateFlags() error {
+	var errMessage strings.Builder
+	// mandatory flags
+	if c.opts.Name == "" {
+		errMessage.WriteString("\nRequired flag `name` has not been set.")
+	}
+	if c.opts.NodeCount < 1 {
+		errMessage.WriteString("\nThe cluster needs at least 1 node.")
+	}
+	if c.opts.VPCCIDR != "" {
+		if _, _, err := net.ParseCIDR(c.opts.VPCCIDR); err != nil {
+			errMessage.WriteString(fmt.Sprintf("\nFlag `vpc-cidr` must be a CIDR like 192.168.0.0/16, but is %q.", c.opts.VPCCIDR))
+		}
+		if len(c.opts.PrivateSubnets) > 0 || len(c.opts.PublicSubnets) > 0 {
+			errMessage.WriteString("\nFlag `vpc-cidr` cannot be used together with existing subnets.")
+		}
+	}
+	if len(c.opts.Zones) == 1 {
+		errMessage.WriteString("\nEKS needs at least 2 zones.")
+	}
+	for _, z := range c.opts.Zones {
+		if !strings.HasPrefix(z, c.opts.Region) || len(z) <= len(c.opts.Region) {
+			errMessage.WriteString(fmt.Sprintf("\nZone %q is not in the region %q.", z, c.opts.Region))
+		}
+	}
+
+	if errMessage.Len() != 0 {
+		return errors.New(errMessage.String())
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProvisionAWSFlags ensures that the provided command flags are stored in the options.
+func TestProvisionAWSFlags(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+
+	// test default flag values
+	require.Equal(t, "", o.Name, "Default value for the name flag not as expected.")
+	require.Equal(t, "", o.Profile, "Default value for the profile flag not as expected.")
+	require.Equal(t, "1.15", o.KubernetesVersion, "Default value for the kube-version flag not as expected.")
+	require.Equal(t, "eu-central-1", o.Region, "Default value for the region flag not as expected.")
+	require.Empty(t, o.Zones, "Default value for the zones flag not as expected.")
+	require.Equal(t, "m5.xlarge", o.MachineType, "Default value for the type flag not as expected.")
+	require.Equal(t, 30, o.DiskSizeGB, "Default value for the disk-size flag not as expected.")
+	require.Equal(t, 3, o.NodeCount, "Default value for the nodes flag not as expected.")
+	require.Equal(t, "", o.VPCCIDR, "Default value for the vpc-cidr flag not as expected.")
+	require.Equal(t, time.Duration(0), o.TTL, "Default value for the ttl flag not as expected.")
+	require.Equal(t, 500.0, o.CostThreshold, "Default value for the cost-threshold flag not as expected.")
+	require.Equal(t, 0.0, o.MaxCost, "Default value for the max-cost flag not as expected.")
+
+	// test passing flags
+	err := c.ParseFlags([]string{
+		"-n", "my-cluster",
+		"--profile", "dev",
+		"-k", "1.16",
+		"-r", "us-east-1",
+		"-z", "us-east-1a,us-east-1b",
+		"-t", "quantum-computer",
+		"--disk-size", "2000",
+		"--nodes", "7",
+		"--private-subnets", "subnet-1,subnet-2",
+		"--public-subnets", "subnet-3",
+		"--ttl", "24h",
+		"--max-cost", "900",
+	})
+
+	require.NoError(t, err, "Parsing flags should not return an error")
+	require.Equal(t, "my-cluster", o.Name, "The parsed value for the name flag not as expected.")
+	require.Equal(t, "dev", o.Profile, "The parsed value for the profile flag not as expected.")
+	require.Equal(t, "1.16", o.KubernetesVersion, "The parsed value for the kube-version flag not as expected.")
+	require.Equal(t, "us-east-1", o.Region, "The parsed value for the region flag not as expected.")
+	require.Equal(t, []string{"us-east-1a", "us-east-1b"}, o.Zones, "The parsed value for the zones flag not as expected.")
+	require.Equal(t, "quantum-computer", o.MachineType, "The parsed value for the type flag not as expected.")
+	require.Equal(t, 2000, o.DiskSizeGB, "The parsed value for the disk-size flag not as expected.")
+	require.Equal(t, 7, o.NodeCount, "The parsed value for the nodes flag not as expected.")
+	require.Equal(t, []string{"subnet-1", "subnet-2"}, o.PrivateSubnets, "The parsed value for the private-subnets flag not as expected.")
+	require.Equal(t, []string{"subnet-3"}, o.PublicSubnets, "The parsed value for the public-subnets flag not as expected.")
+	require.Equal(t, 24*time.Hour, o.TTL, "The parsed value for the ttl flag not as expected.")
+	require.Equal(t, 900.0, o.MaxCost, "The parsed value for the max-cost flag not as expected.")
+
+	require.Equal(t, []string{
+		"create", "cluster",
+		"--name", "my-cluster",
+		"--region", "us-east-1",
+		"--version", "1.16",
+		"--node-type", "quantum-computer",
+		"--nodes", "7",
+		"--node-volume-size", "2000",
+		"--write-kubeconfig=false",
+		"--zones", "us-east-1a,us-east-1b",
+		"--vpc-private-subnets", "subnet-1,subnet-2",
+		"--vpc-public-subnets", "subnet-3",
+	}, createArgs(o))
+}
+
+func TestValidateFlags(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+	cmd := command{opts: o}
+
+	require.Error(t, cmd.validateFlags(), "the name is required")
+
+	require.NoError(t, c.ParseFlags([]string{"-n", "my-cluster", "--vpc-cidr", "10.0.0", "--public-subnets", "subnet-1", "-z", "eu-west-1a"}))
+	err := cmd.validateFlags()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Flag `vpc-cidr` must be a CIDR")
+	require.Contains(t, err.Error(), "cannot be used together with existing subnets")
+	require.Contains(t, err.Error(), "EKS needs at least 2 zones")
+	require.Contains(t, err.Error(), `Zone "eu-west-1a" is not in the region "eu-central-1"`)
+}
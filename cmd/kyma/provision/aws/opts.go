@@ -0,0 +1,36 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/pricing"
+)
+
+type Options struct {
+	*cli.Options
+
+	Name              string
+	Profile           string
+	KubernetesVersion string
+	Region            string
+	Zones             []string
+	MachineType       string
+	DiskSizeGB        int
+	NodeCount         int
+	VPCCIDR           string
+	PrivateSubnets    []string
+	PublicSubnets     []string
+	TTL               time.Duration
+	MaxCost           float64
+	CostThreshold     float64
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
+
+func (o *Options) costLimits() pricing.Limits {
+	return pricing.Limits{Confirm: o.CostThreshold, Max: o.MaxCost}
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/hydroform/provision/types"
 	"github.com/kyma-project/cli/internal/cli"
@@ -22,6 +23,7 @@ func TestProvisionAzureFlags(t *testing.T) {
 	require.Equal(t, "Standard_D2_v3", o.MachineType, "Default value for the type flag not as expected.")
 	require.Equal(t, 35, o.DiskSizeGB, "Default value for the disk-size flag not as expected.")
 	require.Equal(t, 3, o.NodeCount, "Default value for the nodes flag not as expected.")
+	require.Equal(t, time.Duration(0), o.TTL, "Default value for the ttl flag not as expected.")
 	// Temporary disable flag. To be enabled when hydroform supports TF modules
 	//require.Empty(t, o.Extra, "Default value for the extra flag not as expected.")
 
@@ -35,6 +37,7 @@ func TestProvisionAzureFlags(t *testing.T) {
 		"-t", "quantum-computer",
 		"--disk-size", "2000",
 		"--nodes", "7",
+		"--ttl", "72h",
 		// Temporary disable flag. To be enabled when hydroform supports TF modules
 		//"--extra", "VAR1=VALUE1,VAR2=VALUE2",
 	})
@@ -47,6 +50,7 @@ func TestProvisionAzureFlags(t *testing.T) {
 	require.Equal(t, "quantum-computer", o.MachineType, "The parsed value for the type flag not as expected.")
 	require.Equal(t, 2000, o.DiskSizeGB, "The parsed value for the disk-size flag not as expected.")
 	require.Equal(t, 7, o.NodeCount, "The parsed value for the nodes flag not as expected.")
+	require.Equal(t, 72*time.Hour, o.TTL, "The parsed value for the ttl flag not as expected.")
 	// Temporary disable flag. To be enabled when hydroform supports TF modules
 	//require.Equal(t, []string{"VAR1=VALUE1", "VAR2=VALUE2"}, o.Extra, "The parsed value for the extra flag not as expected.")
 }
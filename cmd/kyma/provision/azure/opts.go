@@ -1,10 +1,16 @@
 package azure
 
-import "github.com/kyma-project/cli/internal/cli"
+import (
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/pricing"
+)
 
 type Options struct {
 	*cli.Options
 
+	File              string
 	Name              string
 	Project           string
 	CredentialsFile   string
@@ -13,6 +19,9 @@ type Options struct {
 	MachineType       string
 	DiskSizeGB        int
 	NodeCount         int
+	TTL               time.Duration
+	MaxCost           float64
+	CostThreshold     float64
 	Extra             []string
 }
 
@@ -20,3 +29,7 @@ type Options struct {
 func NewOptions(o *cli.Options) *Options {
 	return &Options{Options: o}
 }
+
+func (o *Options) costLimits() pricing.Limits {
+	return pricing.Limits{Confirm: o.CostThreshold, Max: o.MaxCost}
+}
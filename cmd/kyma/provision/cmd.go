@@ -1,14 +1,55 @@
 package provision
 
 import (
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/clusters"
 	"github.com/spf13/cobra"
 )
 
 //NewCmd creates a new provision command
 func NewCmd() *cobra.Command {
+	var file string
 	cmd := &cobra.Command{
 		Use:   "provision",
 		Short: "Provisions a cluster for Kyma installation.",
+		Long: `Use the subcommands to provision a cluster on the provider of your choice.
+Alternatively, define the cluster in a cluster file and provision it with ` + "`kyma provision -f cluster.yaml`" + `. To override values of the file, pass the file to the subcommand of the provider together with the flags, for example ` + "`kyma provision gardener -f cluster.yaml --nodes 5`" + `.
+
+Before a cluster is provisioned on GCP, Azure, or Gardener, the estimated cost of its nodes is shown. The estimate is based on the list prices shipped with the Kyma CLI. To update them or add machine types, define the prices in the ` + "`pricing.yaml`" + ` file in the Kyma CLI home directory, for example:
+
+  gcp:
+    clusterHour: 0.10
+    diskGBMonth: 0.04
+    machines:
+      n1-standard-4: 0.19`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if file == "" {
+				return cmd.Help()
+			}
+			return provisionFile(cmd, file)
+		},
 	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to a cluster file defining the cluster to provision.")
 	return cmd
 }
+
+// provisionFile runs the subcommand of the provider defined in the cluster file
+func provisionFile(cmd *cobra.Command, path string) error {
+	f, err := clusters.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	for _, sub := range cmd.Commands() {
+		if sub.Name() != string(f.Provider) {
+			continue
+		}
+		if err := sub.Flags().Set("file", path); err != nil {
+			return err
+		}
+		return sub.RunE(sub, nil)
+	}
+	return fmt.Errorf("no provision command for the provider %s", f.Provider)
+}
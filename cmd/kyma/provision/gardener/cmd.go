@@ -1,10 +1,10 @@
 package gardener
 
 import (
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"strings"
 
 	"github.com/kyma-project/cli/internal/kube"
@@ -13,7 +13,10 @@ import (
 	hf "github.com/kyma-incubator/hydroform/provision"
 	"github.com/kyma-incubator/hydroform/provision/types"
 	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
 	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/pricing"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -39,9 +42,15 @@ Use the following instructions to create a service account for a selected provid
 - AWS: Check the roles and create a service account using instructions at https://gardener.cloud/050-tutorials/content/howto/gardener_aws/ 
 - Azure: Create a service account with the ` + "`contributor`" + ` role. Use service account details to create a Secret and store it in Gardener.`,
 
-		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := clusters.ApplyFile(o.File, types.Gardener, cmd.Flags()); err != nil {
+				return err
+			}
+			return c.Run()
+		},
 	}
 
+	cmd.Flags().StringVarP(&o.File, "file", "f", "", "Path to a cluster file defining the cluster to provision. Flags set explicitly override the values of the file.")
 	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the cluster to provision. (required)")
 	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Name of the Gardener project where you provision the cluster. (required)")
 	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the kubeconfig file of the Gardener service account for a target provider. (required)")
@@ -56,6 +65,9 @@ Use the following instructions to create a service account for a selected provid
 	cmd.Flags().StringVar(&o.WCIDR, "workercidr", "10.250.0.0/16", "Specifies Gardener Classless Inter-Domain Routing (CIDR) of the workers of the cluster.")
 	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Disk size (in GB) of the cluster.")
 	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, `Number of cluster nodes which also defines the maximum autoscale value for the cluster. The value you provide for this flag overrides the settings defined with the "scaler-max" flag.`)
+	cmd.Flags().DurationVar(&o.TTL, "ttl", 0, "Time to live of the cluster, for example 72h. Clusters living longer are flagged as overdue in the list of provisioned clusters.")
+	cmd.Flags().Float64Var(&o.CostThreshold, "cost-threshold", 500, "Estimated monthly cost (in USD) above which you must confirm the provisioning. Set it to 0 to disable the confirmation.")
+	cmd.Flags().Float64Var(&o.MaxCost, "max-cost", 0, "Maximum estimated monthly cost (in USD) of the cluster. If the estimate is higher or unknown, the command fails, for example in CI pipelines. By default, there is no maximum.")
 	cmd.Flags().IntVar(&o.ScalerMin, "scaler-min", 2, "Minimum autoscale value of the cluster.")
 	cmd.Flags().IntVar(&o.ScalerMax, "scaler-max", 3, `Maximum autoscale value of the cluster. If you use the "nodes" flag in parallel to this one, it will result in overriding the "scaler-max" flag.`)
 	cmd.Flags().IntVar(&o.Surge, "surge", 3, "Maximum surge of the cluster.")
@@ -82,11 +94,18 @@ func (c *command) Run() error {
 		return err
 	}
 
+	s := c.NewStep("Estimating cost")
+	if err := pricing.Confirm(s, c.opts.TargetProvider, cluster, c.opts.costLimits(), c.opts.NonInteractive); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+
 	if !c.opts.Verbose {
 		// discard all the noise from terraform logs if not verbose
 		log.SetOutput(ioutil.Discard)
 	}
-	s := c.NewStep("Provisioning Gardener cluster")
+	s = c.NewStep("Provisioning Gardener cluster")
 
 	home, err := files.KymaHome()
 	if err != nil {
@@ -94,19 +113,29 @@ func (c *command) Run() error {
 		return err
 	}
 
-	err = retry.Do(
-		func() error {
-			cluster, err = hf.Provision(cluster, provider, types.WithDataDir(home), types.Persistent())
-			return err
-		},
-		retry.Attempts(3))
+	err = c.Interruptible(func() error {
+		return retry.Do(
+			func() error {
+				var err error
+				cluster, err = hf.Provision(cluster, provider, types.WithDataDir(home), types.Persistent())
+				return err
+			},
+			retry.Attempts(3))
+	})
 
 	if err != nil {
 		s.Failure()
+		if c.Interrupted() {
+			return errors.Wrapf(err, "Provisioning interrupted. The Gardener cluster '%s' may be left half-provisioned, check the project '%s' for leftover resources and remove them", c.opts.Name, c.opts.Project)
+		}
 		return err
 	}
 	s.Success()
 
+	if err := clusters.SaveMetadata(home, provider.Type, provider.ProjectName, cluster.Name, clusters.NewMetadata(c.opts.TTL)); err != nil {
+		s.LogErrorf("Could not save the cluster metadata, the cluster will be listed without creation time and TTL: %s", err)
+	}
+
 	s = c.NewStep("Importing kubeconfig")
 	kubeconfig, err := hf.Credentials(cluster, provider, types.WithDataDir(home), types.Persistent())
 	if err != nil {
@@ -198,9 +227,44 @@ func (c *command) validateFlags() error {
 	if c.opts.ScalerMin > c.opts.NodeCount || c.opts.ScalerMin > c.opts.ScalerMax {
 		errMessage.WriteString("\n Minimum node count cannot be greater than maximum number nodes.")
 	}
+	for _, n := range []struct{ flag, cidr string }{
+		{"cidr", c.opts.CIDR},
+		{"workercidr", c.opts.WCIDR},
+		{"network-nodes", c.opts.NetworkNodes},
+		{"network-pods", c.opts.NetworkPods},
+		{"network-services", c.opts.NetworkServices},
+	} {
+		if n.cidr == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(n.cidr); err != nil {
+			errMessage.WriteString(fmt.Sprintf("\nFlag `%s` must be a CIDR like 10.250.0.0/16, but is %q.", n.flag, n.cidr))
+		}
+	}
+	if len(c.opts.Zones) == 0 {
+		errMessage.WriteString("\nAt least one zone is required.")
+	}
+	for _, z := range c.opts.Zones {
+		if !zoneInRegion(c.opts.TargetProvider, c.opts.Region, z) {
+			errMessage.WriteString(fmt.Sprintf("\nZone %q is not in the region %q.", z, c.opts.Region))
+		}
+	}
 
 	if errMessage.Len() != 0 {
 		return errors.New(errMessage.String())
 	}
 	return nil
 }
+
+// zoneInRegion tells if the zone belongs to the region of the target provider.
+// Only GCP and AWS derive their zone names from the region, the zones of other providers are not checked.
+func zoneInRegion(targetProvider, region, zone string) bool {
+	switch targetProvider {
+	case "gcp":
+		return strings.HasPrefix(zone, region+"-")
+	case "aws":
+		return strings.HasPrefix(zone, region) && len(zone) > len(region)
+	default:
+		return true
+	}
+}
@@ -2,6 +2,7 @@ package gardener
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/hydroform/provision/types"
 	"github.com/kyma-project/cli/internal/cli"
@@ -26,6 +27,7 @@ func TestProvisionGardenerFlags(t *testing.T) {
 	require.Equal(t, 30, o.DiskSizeGB, "Default value for the disk-size flag not as expected.")
 	require.Equal(t, "pd-standard", o.DiskType, "Default value for the disk-type flag not as expected.")
 	require.Equal(t, 3, o.NodeCount, "Default value for the nodes flag not as expected.")
+	require.Equal(t, time.Duration(0), o.TTL, "Default value for the ttl flag not as expected.")
 	require.Equal(t, 2, o.ScalerMin, "Default value for the scaler-min flag not as expected.")
 	require.Equal(t, 3, o.ScalerMax, "Default value for the scaler-max flag not as expected.")
 	require.Equal(t, 3, o.Surge, "Default value for the surge flag not as expected.")
@@ -47,6 +49,7 @@ func TestProvisionGardenerFlags(t *testing.T) {
 		"-t", "quantum-computer",
 		"--disk-size", "2000",
 		"--nodes", "7",
+		"--ttl", "72h",
 		"--scaler-min", "88",
 		"--scaler-max", "99",
 		"--surge", "100",
@@ -68,6 +71,7 @@ func TestProvisionGardenerFlags(t *testing.T) {
 	require.Equal(t, 2000, o.DiskSizeGB, "The parsed value for the disk-size flag not as expected.")
 	require.Equal(t, "a big one", o.DiskType, "The parsed value for the disk-type flag not as expected.")
 	require.Equal(t, 7, o.NodeCount, "The parsed value for the nodes flag not as expected.")
+	require.Equal(t, 72*time.Hour, o.TTL, "The parsed value for the ttl flag not as expected.")
 	require.Equal(t, 88, o.ScalerMin, "The parsed value for the scaler-min flag not as expected.")
 	require.Equal(t, 99, o.ScalerMax, "The parsed value for the scaler-max flag not as expected.")
 	require.Equal(t, 100, o.Surge, "The parsed value for the surge flag not as expected.")
@@ -150,3 +154,31 @@ func TestNewProvider(t *testing.T) {
 
 	require.Equal(t, custom, p.CustomConfigurations, "Provider extra configurations not as expected.")
 }
+
+func TestValidateFlags(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+	require.NoError(t, c.ParseFlags([]string{"-n", "my-cluster", "-p", "my-project", "-c", "/kubeconfig", "-s", "my-secret"}))
+
+	cmd := command{opts: o}
+	require.NoError(t, cmd.validateFlags(), "the defaults should be valid")
+
+	o.Zones = []string{"europe-west3-a", "us-central1-b"}
+	o.NetworkPods = "100.96.0.0"
+	o.ScalerMin = 3
+	o.ScalerMax = 2
+	err := cmd.validateFlags()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `Zone "us-central1-b" is not in the region "europe-west3"`)
+	require.Contains(t, err.Error(), "Flag `network-pods` must be a CIDR")
+	require.Contains(t, err.Error(), "Minimum node count cannot be greater than maximum number nodes")
+	require.NotContains(t, err.Error(), "europe-west3-a")
+}
+
+func TestZoneInRegion(t *testing.T) {
+	require.True(t, zoneInRegion("gcp", "europe-west3", "europe-west3-a"))
+	require.False(t, zoneInRegion("gcp", "europe-west3", "europe-west4-a"))
+	require.True(t, zoneInRegion("aws", "eu-central-1", "eu-central-1a"))
+	require.False(t, zoneInRegion("aws", "eu-central-1", "eu-west-1a"))
+	require.True(t, zoneInRegion("azure", "westeurope", "1"))
+}
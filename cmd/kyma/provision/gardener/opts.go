@@ -1,10 +1,16 @@
 package gardener
 
-import "github.com/kyma-project/cli/internal/cli"
+import (
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/pricing"
+)
 
 type Options struct {
 	*cli.Options
 
+	File                string
 	Name                string
 	Project             string
 	CredentialsFile     string
@@ -23,6 +29,9 @@ type Options struct {
 	ScalerMax           int
 	Surge               int
 	Unavailable         int
+	TTL                 time.Duration
+	MaxCost             float64
+	CostThreshold       float64
 	Extra               []string
 	NetworkType         string
 	NetworkNodes        string
@@ -37,3 +46,7 @@ type Options struct {
 func NewOptions(o *cli.Options) *Options {
 	return &Options{Options: o}
 }
+
+func (o *Options) costLimits() pricing.Limits {
+	return pricing.Limits{Confirm: o.CostThreshold, Max: o.MaxCost}
+}
@@ -1,7 +1,6 @@
 package gcp
 
 import (
-	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -13,7 +12,10 @@ import (
 	hf "github.com/kyma-incubator/hydroform/provision"
 	"github.com/kyma-incubator/hydroform/provision/types"
 	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
 	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/pricing"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
@@ -35,9 +37,15 @@ func NewCmd(o *Options) *cobra.Command {
 		Long: `Use this command to provision a Kubernetes cluster on GCP for Kyma installation. Use the flags to specify cluster details.
 NOTE: To access the provisioned cluster, make sure you get authenticated by Google Cloud SDK. To do so,run ` + "`gcloud auth application-default login`" + ` and log in with your Google Cloud credentials.`,
 
-		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := clusters.ApplyFile(o.File, types.GCP, cmd.Flags()); err != nil {
+				return err
+			}
+			return c.Run()
+		},
 	}
 
+	cmd.Flags().StringVarP(&o.File, "file", "f", "", "Path to a cluster file defining the cluster to provision. Flags set explicitly override the values of the file.")
 	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the GKE cluster to provision. (required)")
 	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Name of the GCP Project where you provision the GKE cluster. (required)")
 	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the GCP service account key file. (required)")
@@ -46,6 +54,9 @@ NOTE: To access the provisioned cluster, make sure you get authenticated by Goog
 	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "n1-standard-4", "Machine type used for the cluster.")
 	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 30, "Disk size (in GB) of the cluster.")
 	cmd.Flags().IntVar(&o.NodeCount, "nodes", 3, "Number of cluster nodes.")
+	cmd.Flags().DurationVar(&o.TTL, "ttl", 0, "Time to live of the cluster, for example 72h. Clusters living longer are flagged as overdue in the list of provisioned clusters.")
+	cmd.Flags().Float64Var(&o.CostThreshold, "cost-threshold", 500, "Estimated monthly cost (in USD) above which you must confirm the provisioning. Set it to 0 to disable the confirmation.")
+	cmd.Flags().Float64Var(&o.MaxCost, "max-cost", 0, "Maximum estimated monthly cost (in USD) of the cluster. If the estimate is higher or unknown, the command fails, for example in CI pipelines. By default, there is no maximum.")
 	// Temporary disabled flag. To be enabled when hydroform supports TF modules
 	//cmd.Flags().StringSliceVarP(&o.Extra, "extra", "e", nil, "Provide one or more arguments of the form NAME=VALUE to add extra configurations.")
 
@@ -63,30 +74,47 @@ func (c *command) Run() error {
 		return err
 	}
 
+	s := c.NewStep("Estimating cost")
+	if err := pricing.Confirm(s, string(types.GCP), cluster, c.opts.costLimits(), c.opts.NonInteractive); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Success()
+
 	if !c.opts.Verbose {
 		// discard all the noise from terraform logs if not verbose
 		log.SetOutput(ioutil.Discard)
 	}
-	s := c.NewStep("Provisioning GCP cluster")
+	s = c.NewStep("Provisioning GCP cluster")
 	home, err := files.KymaHome()
 	if err != nil {
 		s.Failure()
 		return err
 	}
 
-	err = retry.Do(
-		func() error {
-			cluster, err = hf.Provision(cluster, provider, types.WithDataDir(home), types.Persistent())
-			return err
-		},
-		retry.Attempts(3))
+	err = c.Interruptible(func() error {
+		return retry.Do(
+			func() error {
+				var err error
+				cluster, err = hf.Provision(cluster, provider, types.WithDataDir(home), types.Persistent())
+				return err
+			},
+			retry.Attempts(3))
+	})
 
 	if err != nil {
 		s.Failure()
+		if c.Interrupted() {
+			return errors.Wrapf(err, "Provisioning interrupted. The GCP cluster '%s' may be left half-provisioned, check the project '%s' for leftover resources and remove them", c.opts.Name, c.opts.Project)
+		}
 		return err
 	}
 	s.Success()
 
+	if err := clusters.SaveMetadata(home, provider.Type, provider.ProjectName, cluster.Name, clusters.NewMetadata(c.opts.TTL)); err != nil {
+		s.LogErrorf("Could not save the cluster metadata, the cluster will be listed without creation time and TTL: %s", err)
+	}
+
 	s = c.NewStep("Importing kubeconfig")
 	kubeconfig, err := hf.Credentials(cluster, provider, types.WithDataDir(home), types.Persistent())
 	if err != nil {
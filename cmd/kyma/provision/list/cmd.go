@@ -0,0 +1,91 @@
+package list
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new list command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the clusters provisioned with the Kyma CLI.",
+		Long: `Use this command to list the clusters you provisioned from this machine, based on the cluster state stored in the Kyma CLI home directory.
+Clusters which live longer than the TTL they were provisioned with are flagged as overdue.`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Aliases: []string{"l"},
+	}
+
+	cmd.Flags().BoolVar(&o.Overdue, "overdue", false, "Lists only the clusters which are overdue for deletion.")
+	return cmd
+}
+
+func (c *command) Run() error {
+	home, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+	list, err := clusters.List(home)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if c.opts.Overdue {
+		var overdue []*clusters.Cluster
+		for _, cl := range list {
+			if cl.Overdue(now) {
+				overdue = append(overdue, cl)
+			}
+		}
+		list = overdue
+	}
+
+	if len(list) == 0 {
+		fmt.Println("No provisioned clusters found")
+		return nil
+	}
+
+	printClusters(os.Stdout, list, now)
+	return nil
+}
+
+func printClusters(out io.Writer, list []*clusters.Cluster, now time.Time) {
+	writer := cli.NewTableWriter([]string{"NAME", "PROVIDER", "PROJECT", "REGION", "KUBERNETES", "NODES", "CREATED", "TTL"}, out)
+	for _, cl := range list {
+		spec := cl.Spec()
+		created := "Unknown"
+		if !cl.Created.IsZero() {
+			created = cl.Created.UTC().Format(time.RFC3339)
+		}
+		writer.Append([]string{
+			cl.Name,
+			string(cl.Provider),
+			cl.Project,
+			spec.Location,
+			spec.KubernetesVersion,
+			strconv.Itoa(spec.NodeCount),
+			created,
+			cl.DescribeTTL(now),
+		})
+	}
+	writer.Render()
+}
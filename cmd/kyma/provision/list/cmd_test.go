@@ -0,0 +1,44 @@
+package list
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_printClusters(t *testing.T) {
+	now := time.Date(2020, 4, 10, 12, 0, 0, 0, time.UTC)
+	list := []*clusters.Cluster{
+		{
+			Name:     "overdue",
+			Project:  "my-project",
+			Provider: types.GCP,
+			Vars: map[string]interface{}{
+				"location":           "europe-west3-a",
+				"kubernetes_version": "1.15",
+				"node_count":         "3",
+			},
+			Created: now.Add(-26 * time.Hour),
+			TTL:     24 * time.Hour,
+		},
+		{
+			Name:     "aks",
+			Project:  "rg",
+			Provider: types.Azure,
+			Vars: map[string]interface{}{
+				"location":    "westeurope",
+				"agent_count": "2",
+			},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	printClusters(out, list, now)
+
+	require.Contains(t, out.String(), "overdue  gcp       my-project  europe-west3-a  1.15        3      2020-04-09T10:00:00Z  24h0m0s (overdue since 2h0m0s)")
+	require.Contains(t, out.String(), "aks      azure     rg          westeurope                  2      Unknown               None")
+}
@@ -0,0 +1,15 @@
+package list
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the command listing the provisioned clusters
+type Options struct {
+	*cli.Options
+
+	Overdue bool
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
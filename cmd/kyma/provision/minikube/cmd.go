@@ -2,6 +2,8 @@ package minikube
 
 import (
 	"fmt"
+	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -23,7 +25,8 @@ const (
 	bootstrapper       string = "kubeadm"
 	vmDriverHyperkit   string = "hyperkit"
 	vmDriverHyperv     string = "hyperv"
-	vmDriverNone       string = "none"
+	vmDriverNone       string = minikube.DriverNone
+	vmDriverDocker     string = minikube.DriverDocker
 	vmDriverVirtualBox string = "virtualbox"
 	sleep                     = 10 * time.Second
 )
@@ -37,7 +40,8 @@ var (
 		vmDriverHyperkit,
 		vmDriverVirtualBox,
 		"kvm2",
-		"none",
+		vmDriverNone,
+		vmDriverDocker,
 	}
 	ErrMinikubeRunning = errors.New("Minikube already running")
 )
@@ -56,18 +60,25 @@ func NewCmd(o *Options) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:     "minikube",
-		Short:   "Provisions Minikube.",
-		Long:    `Use this command to provision a Minikube cluster for Kyma installation.`,
+		Use:   "minikube",
+		Short: "Provisions Minikube.",
+		Long: `Use this command to provision a Minikube cluster for Kyma installation.
+On Linux, use the ` + "`none`" + ` or ` + "`docker`" + ` driver to run Minikube without a hypervisor, for example on CI systems. Both drivers use the Docker daemon of this machine, and the ` + "`docker`" + ` driver requires Minikube 1.8.0 or higher.
+The resources of the Minikube instance are taken from a preset which is sized for the Kyma components you want to install:
+` + presetDescriptions() + `
+Unless you set ` + "`--preset`" + `, the default preset is used. The preset is stored in the cluster, and ` + "`kyma install`" + ` installs the components of the preset.
+On Linux, the command warns if this machine has fewer CPUs or less memory than requested.
+The flags ` + "`--cpus`, `--memory`, and `--disk-size`" + ` override the values of the preset.`,
 		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
 		Aliases: []string{"m"},
 	}
 
 	cmd.Flags().StringVar(&o.VMDriver, "vm-driver", defaultVMDriver, "Specifies the VM driver. Possible values: "+strings.Join(drivers, ","))
 	cmd.Flags().StringVar(&o.HypervVirtualSwitch, "hypervVirtualSwitch", "", "Specifies the Hyper-V switch version if you choose Hyper-V as the driver.")
-	cmd.Flags().StringVar(&o.DiskSize, "disk-size", "30g", "Specifies the disk size used for installation.")
-	cmd.Flags().StringVar(&o.Memory, "memory", "8192", "Specifies RAM reserved for installation.")
-	cmd.Flags().StringVar(&o.CPUS, "cpus", "4", "Specifies the number of CPUs used for installation.")
+	cmd.Flags().StringVar(&o.DiskSize, "disk-size", "", "Specifies the disk size used for installation. By default, the disk size of the preset is used.")
+	cmd.Flags().StringVar(&o.Memory, "memory", "", "Specifies RAM reserved for installation. By default, the memory of the preset is used.")
+	cmd.Flags().StringVar(&o.CPUS, "cpus", "", "Specifies the number of CPUs used for installation. By default, the CPUs of the preset are used.")
+	cmd.Flags().StringVar(&o.Preset, "preset", defaultPreset, "Specifies the preset of resources. Possible values: "+strings.Join(presetNames(), ","))
 	cmd.Flags().StringVar(&o.Profile, "profile", "", "Specifies the Minikube profile.")
 	return cmd
 }
@@ -114,7 +125,7 @@ func (c *command) Run() error {
 	err = c.waitForMinikubeToBeUp(s)
 	if err != nil {
 		s.Failure()
-		return err
+		return c.interruptedErr(err)
 	}
 
 	// K8s client needs to be created here because before the kubeconfig is not ready to use
@@ -130,10 +141,10 @@ func (c *command) Run() error {
 	}
 
 	s.Status("Wait for kube-dns to be up and running")
-	err = c.K8s.WaitPodStatusByLabel("kube-system", "k8s-app", "kube-dns", corev1.PodRunning)
+	err = c.K8s.WaitPods(c.Context(), "kube-system", "k8s-app=kube-dns", kube.PodReady, 0)
 	if err != nil {
 		s.Failure()
-		return err
+		return c.interruptedErr(err)
 	}
 	s.Successf("Minikube up and running")
 
@@ -187,6 +198,54 @@ func (c *command) checkRequirements(s step.Step) error {
 		s.LogError(versionWarning)
 	}
 
+	// drivers without VM need the Docker daemon of the host instead of a hypervisor
+	if err := minikube.CheckDriver(c.opts.Verbose, c.opts.VMDriver); err != nil {
+		s.Failure()
+		return err
+	}
+	if c.opts.VMDriver == vmDriverDocker && runtime.GOOS != "linux" {
+		s.LogErrorf("The '%s' driver runs Minikube in a container which is not reachable from this machine by its IP on %s, so the Kyma domains will not resolve. Use a VM driver instead.", vmDriverDocker, runtime.GOOS)
+	}
+
+	return c.checkResources(s)
+}
+
+// checkResources fills the resources which were not set explicitly from the preset and warns if the host can not provide them
+func (c *command) checkResources(s step.Step) error {
+	p, err := selectPreset(c.opts.Preset)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	c.opts.Preset = p.name
+	if c.opts.CPUS == "" {
+		c.opts.CPUS = strconv.Itoa(p.CPUs)
+	}
+	if c.opts.Memory == "" {
+		c.opts.Memory = strconv.Itoa(p.MemoryMB)
+	}
+	if c.opts.DiskSize == "" {
+		c.opts.DiskSize = fmt.Sprintf("%dg", p.DiskGB)
+	}
+
+	var requested resources
+	if requested.CPUs, err = strconv.Atoi(c.opts.CPUS); err != nil {
+		s.Failure()
+		return fmt.Errorf("Specified number of CPUs '%s' is not a number", c.opts.CPUS)
+	}
+	if requested.MemoryMB, err = parseMemoryMB(c.opts.Memory); err != nil {
+		s.Failure()
+		return err
+	}
+	s.LogInfof("Using the preset '%s' for %s: %s CPUs, %s MB memory, %s disk", p.name, p.components, c.opts.CPUS, c.opts.Memory, c.opts.DiskSize)
+
+	// without a VM, Minikube runs directly on the host and does not reserve the resources
+	if c.opts.VMDriver == vmDriverNone {
+		return nil
+	}
+	for _, w := range exceedingResources(requested, hostResources()) {
+		s.LogErrorf("%s, Minikube may fail to start or Kyma may not become ready. Use a smaller preset or lower the resources with the flags.", w)
+	}
 	return nil
 }
 
@@ -223,14 +282,10 @@ func (c *command) startMinikube() error {
 	startCmd := []string{"start",
 		"--memory", c.opts.Memory,
 		"--cpus", c.opts.CPUS,
-		"--extra-config=apiserver.authorization-mode=RBAC",
-		"--extra-config=apiserver.cors-allowed-origins='http://*'",
-		"--extra-config=apiserver.enable-admission-plugins=DefaultStorageClass,LimitRanger,MutatingAdmissionWebhook,NamespaceExists,NamespaceLifecycle,ResourceQuota,ServiceAccount,ValidatingAdmissionWebhook",
-		"--kubernetes-version=v" + kubernetesVersion,
 		"--vm-driver", c.opts.VMDriver,
 		"--disk-size", c.opts.DiskSize,
-		"-b", bootstrapper,
 	}
+	startCmd = append(startCmd, KubernetesArgs()...)
 
 	if c.opts.VMDriver == vmDriverHyperv {
 		startCmd = append(startCmd, "--hyperv-virtual-switch="+c.opts.HypervVirtualSwitch)
@@ -242,6 +297,18 @@ func (c *command) startMinikube() error {
 	return nil
 }
 
+//KubernetesArgs returns the arguments of minikube start which configure Kubernetes for Kyma.
+//They must be passed again when a stopped Minikube is started, otherwise Minikube starts Kubernetes with its defaults.
+func KubernetesArgs() []string {
+	return []string{
+		"--extra-config=apiserver.authorization-mode=RBAC",
+		"--extra-config=apiserver.cors-allowed-origins='http://*'",
+		"--extra-config=apiserver.enable-admission-plugins=DefaultStorageClass,LimitRanger,MutatingAdmissionWebhook,NamespaceExists,NamespaceLifecycle,ResourceQuota,ServiceAccount,ValidatingAdmissionWebhook",
+		"--kubernetes-version=v" + kubernetesVersion,
+		"-b", bootstrapper,
+	}
+}
+
 // fixes https://github.com/kyma-project/kyma/issues/1986
 func (c *command) createClusterRoleBinding() error {
 	var err error
@@ -282,7 +349,9 @@ func (c *command) waitForMinikubeToBeUp(step step.Step) error {
 		if strings.TrimSpace(statusText) == "Running" {
 			break
 		}
-		time.Sleep(sleep)
+		if err := c.wait(); err != nil {
+			return err
+		}
 	}
 
 	for {
@@ -295,21 +364,46 @@ func (c *command) waitForMinikubeToBeUp(step step.Step) error {
 		if strings.TrimSpace(statusText) == "Running" {
 			break
 		}
-		time.Sleep(sleep)
+		if err := c.wait(); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// wait pauses until the next status check or until the command is interrupted
+func (c *command) wait() error {
+	select {
+	case <-c.Context().Done():
+		return errors.Wrap(c.Context().Err(), "stopped waiting for Minikube to be up and running")
+	case <-time.After(sleep):
+		return nil
+	}
+}
+
+// interruptedErr informs about the half-provisioned Minikube instance if the command was interrupted
+func (c *command) interruptedErr(err error) error {
+	if !c.Interrupted() {
+		return err
+	}
+	return errors.Wrapf(err, "Provisioning interrupted. The Minikube instance '%s' may be left half-configured, run `minikube delete -p %s` to remove it", c.opts.Profile, c.opts.Profile)
+}
+
 // Default value of 128 is not enough to perform “kubectl log -f” from pods, hence increased to 524288
 func (c *command) increaseFsInotifyMaxUserInstances() error {
-	if c.opts.VMDriver != vmDriverNone {
+	if c.opts.VMDriver != vmDriverNone && c.opts.VMDriver != vmDriverDocker {
 		_, err := minikube.RunCmd(c.opts.Verbose, c.opts.Profile, "ssh", "--", "sudo sysctl -w fs.inotify.max_user_instances=524288")
 		if err != nil {
 			return err
 		}
+		return nil
 	}
 
+	// without a VM, the nodes share the kernel of the host, whose setting is read-only in the Minikube container
+	if _, err := cli.RunCmd("sudo", "sysctl", "-w", "fs.inotify.max_user_instances=524288"); err != nil {
+		c.CurrentStep.LogErrorf("Could not increase fs.inotify.max_user_instances on this machine, run `sudo sysctl -w fs.inotify.max_user_instances=524288` manually: %s", err)
+	}
 	return nil
 }
 
@@ -331,6 +425,7 @@ func (c *command) printSummary() error {
 		fmt.Println(clusterInfo)
 	}
 
+	fmt.Printf("Resources: %s CPUs, %s MB memory, %s disk (preset '%s')\n\n", c.opts.CPUS, c.opts.Memory, c.opts.DiskSize, c.opts.Preset)
 	fmt.Println("Happy Minikube-ing! :)")
 	return nil
 }
@@ -364,6 +459,7 @@ func (c *command) createClusterInfoConfigMap() error {
 			"profile":       c.opts.Profile,
 			"localIP":       minikubeIP,
 			"localVMDriver": c.opts.VMDriver,
+			"preset":        c.opts.Preset,
 		},
 	})
 
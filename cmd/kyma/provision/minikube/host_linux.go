@@ -0,0 +1,19 @@
+package minikube
+
+import (
+	"os"
+)
+
+// hostResources detects the CPUs and memory of the host from /proc, unknown values are 0
+func hostResources() resources {
+	var host resources
+	if f, err := os.Open("/proc/cpuinfo"); err == nil {
+		host.CPUs = parseCPUInfo(f)
+		f.Close()
+	}
+	if f, err := os.Open("/proc/meminfo"); err == nil {
+		host.MemoryMB = parseMemInfo(f)
+		f.Close()
+	}
+	return host
+}
@@ -0,0 +1,8 @@
+// +build !linux
+
+package minikube
+
+// hostResources can only detect the resources of the host on Linux, on other systems they are unknown
+func hostResources() resources {
+	return resources{}
+}
@@ -11,6 +11,7 @@ type Options struct {
 	CPUS                string
 	HypervVirtualSwitch string
 	Profile             string
+	Preset              string
 }
 
 //NewOptions creates options with default values
@@ -0,0 +1,131 @@
+package minikube
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-project/cli/pkg/installation"
+)
+
+const defaultPreset = installation.PresetDefault
+
+// resources are the resources of the Minikube VM or of the host
+type resources struct {
+	CPUs     int
+	MemoryMB int
+	DiskGB   int
+}
+
+// preset is a set of resources sized for the Kyma components which "kyma install" installs on the cluster afterwards
+type preset struct {
+	name       string
+	components string
+	resources
+}
+
+// presets are ordered from the smallest to the largest
+var presets = []preset{
+	{
+		name:       installation.PresetMinimal,
+		components: "the Kyma core components and Istio, without monitoring, logging, tracing, and Knative",
+		resources:  resources{CPUs: 2, MemoryMB: 6144, DiskGB: 20},
+	},
+	{
+		name:       installation.PresetDefault,
+		components: "the components of the default Kyma installation",
+		resources:  resources{CPUs: 4, MemoryMB: 8192, DiskGB: 30},
+	},
+	{
+		name:       installation.PresetFull,
+		components: "the components of the default Kyma installation and the optional NATS Streaming eventing components",
+		resources:  resources{CPUs: 6, MemoryMB: 12288, DiskGB: 50},
+	},
+}
+
+func presetNames() []string {
+	var names []string
+	for _, p := range presets {
+		names = append(names, p.name)
+	}
+	return names
+}
+
+// selectPreset returns the preset with the given name
+func selectPreset(name string) (preset, error) {
+	for _, p := range presets {
+		if p.name == name {
+			return p, nil
+		}
+	}
+	return preset{}, fmt.Errorf("unknown preset '%s', use one of %s", name, strings.Join(presetNames(), ", "))
+}
+
+// exceedingResources describes the requested resources which the host can not provide, host resources which are unknown are not checked
+func exceedingResources(requested, host resources) []string {
+	var warnings []string
+	if host.CPUs > 0 && requested.CPUs > host.CPUs {
+		warnings = append(warnings, fmt.Sprintf("The requested %d CPUs exceed the %d CPUs of this machine", requested.CPUs, host.CPUs))
+	}
+	if host.MemoryMB > 0 && requested.MemoryMB > host.MemoryMB {
+		warnings = append(warnings, fmt.Sprintf("The requested %d MB of memory exceed the %d MB of this machine", requested.MemoryMB, host.MemoryMB))
+	}
+	return warnings
+}
+
+// parseMemoryMB reads a memory size as minikube accepts it, for example 8192, 8192mb or 8g
+func parseMemoryMB(s string) (int, error) {
+	size := strings.ToLower(strings.TrimSpace(s))
+	factor := 1
+	switch {
+	case strings.HasSuffix(size, "gb"), strings.HasSuffix(size, "g"):
+		factor = 1024
+		size = strings.TrimRight(size, "gb")
+	case strings.HasSuffix(size, "mb"), strings.HasSuffix(size, "m"):
+		size = strings.TrimRight(size, "mb")
+	}
+	v, err := strconv.Atoi(size)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory size '%s'", s)
+	}
+	return v * factor, nil
+}
+
+// parseCPUInfo counts the processors listed in /proc/cpuinfo
+func parseCPUInfo(r io.Reader) int {
+	cpus := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "processor") {
+			cpus++
+		}
+	}
+	return cpus
+}
+
+// parseMemInfo reads the total memory in MB from /proc/meminfo
+func parseMemInfo(r io.Reader) int {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "MemTotal:" {
+			kb, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return 0
+			}
+			return kb / 1024
+		}
+	}
+	return 0
+}
+
+// presetDescriptions lists the presets with their resources for the help of the command
+func presetDescriptions() string {
+	var b strings.Builder
+	for _, p := range presets {
+		b.WriteString(fmt.Sprintf("  - %s: %d CPUs, %d MB memory, %d GB disk, for %s.\n", p.name, p.CPUs, p.MemoryMB, p.DiskGB, p.components))
+	}
+	return b.String()
+}
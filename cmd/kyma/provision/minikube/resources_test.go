@@ -0,0 +1,64 @@
+package minikube
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectPreset(t *testing.T) {
+	tests := []struct {
+		name   string
+		preset string
+		want   string
+		err    bool
+	}{
+		{name: "explicit preset", preset: "full", want: "full"},
+		{name: "unknown preset", preset: "huge", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := selectPreset(tt.preset)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, p.name)
+		})
+	}
+}
+
+func TestDefaultPreset(t *testing.T) {
+	p, err := selectPreset(defaultPreset)
+	require.NoError(t, err)
+	require.Equal(t, resources{CPUs: 4, MemoryMB: 8192, DiskGB: 30}, p.resources, "the default resources must not change")
+}
+
+func TestExceedingResources(t *testing.T) {
+	requested := resources{CPUs: 4, MemoryMB: 8192}
+	require.Empty(t, exceedingResources(requested, resources{CPUs: 8, MemoryMB: 16384}))
+	require.Empty(t, exceedingResources(requested, resources{}), "unknown host resources must not be checked")
+	require.Len(t, exceedingResources(requested, resources{CPUs: 2, MemoryMB: 4096}), 2)
+}
+
+func TestParseMemoryMB(t *testing.T) {
+	for in, want := range map[string]int{"8192": 8192, "8192mb": 8192, "8g": 8192, "8GB": 8192, "512m": 512} {
+		got, err := parseMemoryMB(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+	_, err := parseMemoryMB("lots")
+	require.Error(t, err)
+}
+
+func TestParseProcFiles(t *testing.T) {
+	cpuinfo := "processor\t: 0\nmodel name\t: CPU\n\nprocessor\t: 1\nmodel name\t: CPU\n"
+	require.Equal(t, 2, parseCPUInfo(strings.NewReader(cpuinfo)))
+
+	meminfo := "MemTotal:       16318112 kB\nMemFree:         1234567 kB\n"
+	require.Equal(t, 15935, parseMemInfo(strings.NewReader(meminfo)))
+	require.Equal(t, 0, parseMemInfo(strings.NewReader("")))
+}
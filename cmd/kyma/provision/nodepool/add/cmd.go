@@ -0,0 +1,74 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new command adding a node pool
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "add <cluster>",
+		Short: "Adds a node pool to a cluster provisioned with the Kyma CLI.",
+		Long: `Use this command to add a node pool to a GCP or Azure cluster you provisioned from this machine.
+Node pools are not supported for Gardener and AWS clusters.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error { return c.Run(args[0]) },
+	}
+
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the node pool. (required)")
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 1, "Number of nodes of the node pool. For regional GCP clusters, it is the total number of nodes, which must be a multiple of the number of zones of the cluster.")
+	cmd.Flags().StringVarP(&o.MachineType, "type", "t", "", "Machine type of the nodes. By default, the default of the provider is used.")
+	cmd.Flags().IntVar(&o.DiskSizeGB, "disk-size", 0, "Disk size (in GB) of the nodes. By default, the default of the provider is used.")
+	cmd.Flags().StringVar(&o.Provider, "provider", "", "Provider of the cluster. Only needed if clusters with the same name exist for several providers.")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Project of the cluster. Only needed if clusters with the same name exist in several projects.")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the credentials file to use instead of the one the cluster was provisioned with.")
+	return cmd
+}
+
+func (c *command) Run(cluster string) error {
+	if c.opts.Name == "" {
+		return errors.New("Required flag `name` has not been set.")
+	}
+
+	home, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+	state, err := clusters.Find(home, c.opts.providerType(), c.opts.Project, cluster)
+	if err != nil {
+		return err
+	}
+
+	s := c.NewStep(fmt.Sprintf("Adding node pool '%s'", c.opts.Name))
+	pool := clusters.NodePool{
+		Name:        c.opts.Name,
+		NodeCount:   c.opts.NodeCount,
+		MachineType: c.opts.MachineType,
+		DiskSizeGB:  c.opts.DiskSizeGB,
+	}
+	err = c.Interruptible(func() error {
+		return state.AddNodePool(c.Context(), home, c.opts.CredentialsFile, c.opts.Verbose, pool)
+	})
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Node pool '%s' with %d nodes added to the cluster '%s'", pool.Name, pool.NodeCount, state.Name)
+	return nil
+}
@@ -0,0 +1,19 @@
+package nodepool
+
+import (
+	"github.com/spf13/cobra"
+)
+
+//NewCmd creates a new nodepool command
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodepool",
+		Short: "Manages additional node pools of clusters provisioned with the Kyma CLI.",
+		Long: `Use this command to add node pools to a GCP or Azure cluster you provisioned from this machine, or to remove them again.
+
+The stored cluster specification only creates the node pool the cluster was provisioned with, so additional node pools are added and removed with ` + "`gcloud`" + ` or ` + "`az`" + `. The node pools are then recorded in the cluster specification, and the stored state is updated from the cluster. Make sure the CLI of the provider is installed and authenticated.
+
+NOTE: Gardener and AWS clusters are not supported. The Gardener cluster state defines a single worker group, which the next change of the cluster would restore, so additional workers would be removed again. To change the size of a Gardener cluster, use ` + "`kyma provision scale`" + `.`,
+	}
+	return cmd
+}
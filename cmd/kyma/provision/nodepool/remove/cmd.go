@@ -0,0 +1,69 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new command removing a node pool
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <cluster>",
+		Short: "Removes a node pool added with the Kyma CLI from a cluster.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  func(_ *cobra.Command, args []string) error { return c.Run(args[0]) },
+	}
+
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the node pool. (required)")
+	cmd.Flags().StringVar(&o.Provider, "provider", "", "Provider of the cluster. Only needed if clusters with the same name exist for several providers.")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Project of the cluster. Only needed if clusters with the same name exist in several projects.")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the credentials file to use instead of the one the cluster was provisioned with.")
+	return cmd
+}
+
+func (c *command) Run(cluster string) error {
+	if c.opts.Name == "" {
+		return errors.New("Required flag `name` has not been set.")
+	}
+
+	home, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+	state, err := clusters.Find(home, c.opts.providerType(), c.opts.Project, cluster)
+	if err != nil {
+		return err
+	}
+
+	s := c.NewStep(fmt.Sprintf("Removing node pool '%s'", c.opts.Name))
+	if !c.opts.NonInteractive {
+		if !s.PromptYesNo(fmt.Sprintf("Do you really want to remove the node pool '%s' from the cluster '%s'? ", c.opts.Name, state.Name)) {
+			s.Failure()
+			return errors.New("Removing the node pool aborted")
+		}
+	}
+	err = c.Interruptible(func() error {
+		return state.RemoveNodePool(c.Context(), home, c.opts.CredentialsFile, c.opts.Verbose, c.opts.Name)
+	})
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Node pool '%s' removed from the cluster '%s'", c.opts.Name, state.Name)
+	return nil
+}
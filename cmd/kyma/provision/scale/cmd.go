@@ -0,0 +1,120 @@
+package scale
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new scale command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "scale <name>",
+		Short: "Changes the number of nodes of a cluster provisioned with the Kyma CLI.",
+		Long: `Use this command to change the number of nodes of a GCP, Azure, or Gardener cluster you provisioned from this machine.
+Azure and Gardener clusters are changed by applying the updated cluster specification to the cluster state stored in the Kyma CLI home directory.
+GCP clusters are resized with ` + "`gcloud`" + `, because changing the node count of the stored cluster specification would recreate the cluster. The new node count is then recorded in the cluster specification, and the stored state is updated from the cluster. Make sure you are authenticated with the Google Cloud SDK.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error { return c.Run(args[0]) },
+	}
+
+	cmd.Flags().IntVar(&o.NodeCount, "nodes", 0, "Number of cluster nodes. For Gardener clusters, it is the maximum autoscale value. For regional GCP clusters, it is the total number of nodes, which must be a multiple of the number of zones of the cluster. (required)")
+	cmd.Flags().IntVar(&o.ScalerMin, "scaler-min", 0, "Minimum autoscale value of a Gardener cluster. By default, the current value is kept.")
+	cmd.Flags().StringVar(&o.Provider, "provider", "", "Provider of the cluster. Only needed if clusters with the same name exist for several providers.")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Project of the cluster. Only needed if clusters with the same name exist in several projects.")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the credentials file to use instead of the one the cluster was provisioned with.")
+	return cmd
+}
+
+func (c *command) Run(name string) error {
+	if c.opts.NodeCount < 1 {
+		return errors.New("Required flag `nodes` has not been set or is less than 1.")
+	}
+
+	s := c.NewStep("Loading cluster state")
+	home, err := files.KymaHome()
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	state, err := clusters.Find(home, c.opts.providerType(), c.opts.Project, name)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	if !state.HasState() {
+		s.Failure()
+		return fmt.Errorf("the cluster '%s' has no persisted state, it was probably never provisioned successfully", name)
+	}
+	if state.Provider == types.AWS {
+		s.Failure()
+		return fmt.Errorf("scaling AWS clusters is not supported, scale the node group of the cluster '%s' with eksctl", name)
+	}
+	if c.opts.ScalerMin > 0 && state.Provider != types.Gardener {
+		s.Failure()
+		return errors.New("the flag `scaler-min` is only supported for Gardener clusters")
+	}
+	s.Successf("Cluster state loaded from %s", state.Dir)
+
+	s = c.NewStep(fmt.Sprintf("Scaling cluster to %d nodes", c.opts.NodeCount))
+	err = c.Interruptible(func() error {
+		if state.Provider == types.GCP {
+			return state.Resize(c.Context(), home, c.opts.CredentialsFile, c.opts.Verbose, c.opts.NodeCount)
+		}
+		return state.Apply(home, c.opts.CredentialsFile, c.opts.Verbose, func(cluster *types.Cluster, provider *types.Provider) error {
+			return scale(cluster, provider, c.opts.NodeCount, c.opts.ScalerMin)
+		})
+	})
+	if err != nil {
+		s.Failure()
+		if c.Interrupted() {
+			return errors.Wrapf(err, "Scaling interrupted. The cluster '%s' may be left half-scaled, run the command again to finish it", name)
+		}
+		return err
+	}
+	s.Success()
+
+	fmt.Printf("\n%s cluster '%s' scaled to %d nodes\n", state.Provider, state.Name, c.opts.NodeCount)
+	return nil
+}
+
+// scale sets the node count in the hydroform specification of the cluster
+func scale(cluster *types.Cluster, provider *types.Provider, nodes, scalerMin int) error {
+	cluster.NodeCount = nodes
+	if provider.Type != types.Gardener {
+		if scalerMin > 0 {
+			return errors.New("the flag `scaler-min` is only supported for Gardener clusters")
+		}
+		return nil
+	}
+
+	// the node count of gardener clusters is the maximum of the autoscaler, the same as when provisioning
+	provider.CustomConfigurations["worker_maximum"] = nodes
+	min := scalerMin
+	if min > 0 {
+		provider.CustomConfigurations["worker_minimum"] = min
+	} else {
+		// the persisted minimum is a string read from the hydroform state
+		min, _ = strconv.Atoi(fmt.Sprint(provider.CustomConfigurations["worker_minimum"]))
+	}
+	if min > nodes {
+		return fmt.Errorf("the minimum autoscale value %d cannot be greater than the number of nodes %d", min, nodes)
+	}
+	return nil
+}
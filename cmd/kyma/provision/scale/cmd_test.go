@@ -0,0 +1,38 @@
+package scale
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScale(t *testing.T) {
+	t.Run("azure", func(t *testing.T) {
+		cluster := &types.Cluster{NodeCount: 3}
+		provider := &types.Provider{Type: types.Azure, CustomConfigurations: map[string]interface{}{}}
+
+		require.NoError(t, scale(cluster, provider, 5, 0))
+		require.Equal(t, 5, cluster.NodeCount)
+		require.Empty(t, provider.CustomConfigurations)
+
+		require.Error(t, scale(cluster, provider, 5, 2), "scaler-min is only supported for gardener")
+	})
+
+	t.Run("gardener", func(t *testing.T) {
+		cluster := &types.Cluster{NodeCount: 3}
+		provider := &types.Provider{Type: types.Gardener, CustomConfigurations: map[string]interface{}{
+			"worker_minimum": "2",
+			"worker_maximum": "3",
+		}}
+
+		require.NoError(t, scale(cluster, provider, 6, 0))
+		require.Equal(t, 6, cluster.NodeCount)
+		require.Equal(t, 6, provider.CustomConfigurations["worker_maximum"])
+		require.Equal(t, "2", provider.CustomConfigurations["worker_minimum"], "the persisted minimum should be kept")
+
+		require.Error(t, scale(cluster, provider, 1, 0), "the persisted minimum is greater than the nodes")
+		require.NoError(t, scale(cluster, provider, 4, 4))
+		require.Equal(t, 4, provider.CustomConfigurations["worker_minimum"])
+	})
+}
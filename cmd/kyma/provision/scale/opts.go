@@ -0,0 +1,26 @@
+package scale
+
+import (
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+//Options defines available options for the command scaling a provisioned cluster
+type Options struct {
+	*cli.Options
+
+	Provider        string
+	Project         string
+	CredentialsFile string
+	NodeCount       int
+	ScalerMin       int
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
+
+func (o *Options) providerType() types.ProviderType {
+	return types.ProviderType(o.Provider)
+}
@@ -0,0 +1,145 @@
+package status
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+
+	hf "github.com/kyma-incubator/hydroform/provision"
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/eksctl"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new status command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status <name>",
+		Short: "Shows the status of a cluster provisioned with the Kyma CLI.",
+		Long: `Use this command to show the details of a cluster you provisioned from this machine and to query its live status from the provider.
+The command uses the cluster state stored in the Kyma CLI home directory.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error { return c.Run(args[0]) },
+	}
+
+	cmd.Flags().StringVar(&o.Provider, "provider", "", "Provider of the cluster. Only needed if clusters with the same name exist for several providers.")
+	cmd.Flags().StringVarP(&o.Project, "project", "p", "", "Project of the cluster. Only needed if clusters with the same name exist in several projects.")
+	cmd.Flags().StringVarP(&o.CredentialsFile, "credentials", "c", "", "Path to the credentials file to use instead of the one the cluster was provisioned with.")
+	return cmd
+}
+
+func (c *command) Run(name string) error {
+	s := c.NewStep("Loading cluster state")
+	home, err := files.KymaHome()
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	state, err := clusters.Find(home, c.opts.providerType(), c.opts.Project, name)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Cluster state loaded from %s", state.Dir)
+
+	phase := types.Unknown
+	if state.HasState() {
+		s = c.NewStep("Querying cluster status")
+		if phase, err = c.phase(home, state); err != nil {
+			s.Failure()
+			return err
+		}
+		s.Success()
+	} else {
+		s.LogInfo("No cluster state was persisted, the cluster was probably never provisioned successfully")
+	}
+
+	fmt.Println()
+	printStatus(os.Stdout, state, phase, time.Now())
+	if state.Overdue(time.Now()) {
+		fmt.Printf("\nThe cluster is overdue for deletion, remove it with `kyma deprovision %s -n %s`\n", state.Provider, state.Name)
+	}
+	return nil
+}
+
+// phase queries the live status of the cluster, EKS clusters are queried with eksctl as hydroform does not support AWS
+func (c *command) phase(home string, state *clusters.Cluster) (types.Phase, error) {
+	if state.Provider == types.AWS {
+		status, err := eksctl.ClusterStatus(c.Context(), c.opts.Verbose, state.AWSProfile(), state.Name, state.Spec().Location)
+		if err != nil {
+			return types.Unknown, err
+		}
+		return eksPhase(status), nil
+	}
+
+	cluster, provider, err := state.Hydroform(c.opts.CredentialsFile)
+	if err != nil {
+		return types.Unknown, err
+	}
+	if !c.opts.Verbose {
+		// discard all the noise from terraform logs if not verbose
+		log.SetOutput(ioutil.Discard)
+	}
+	status, err := hf.Status(cluster, provider, types.WithDataDir(home), types.Persistent())
+	if err != nil {
+		return types.Unknown, err
+	}
+	return status.Phase, nil
+}
+
+// eksPhase maps the EKS cluster status to the phases of hydroform, transitional states such as CREATING are shown as they are
+func eksPhase(status string) types.Phase {
+	switch status {
+	case "ACTIVE":
+		return types.Provisioned
+	case "FAILED":
+		return types.Errored
+	default:
+		return types.Phase(status)
+	}
+}
+
+func printStatus(out io.Writer, state *clusters.Cluster, phase types.Phase, now time.Time) {
+	spec := state.Spec()
+	created := "Unknown"
+	if !state.Created.IsZero() {
+		created = state.Created.UTC().Format(time.RFC3339)
+	}
+
+	fmt.Fprintf(out, "Name:          %s\n", state.Name)
+	fmt.Fprintf(out, "Provider:      %s\n", state.Provider)
+	fmt.Fprintf(out, "Project:       %s\n", state.Project)
+	fmt.Fprintf(out, "Region:        %s\n", spec.Location)
+	fmt.Fprintf(out, "Kubernetes:    %s\n", spec.KubernetesVersion)
+	fmt.Fprintf(out, "Nodes:         %d\n", spec.NodeCount)
+	fmt.Fprintf(out, "Machine type:  %s\n", spec.MachineType)
+	fmt.Fprintf(out, "Created:       %s\n", created)
+	fmt.Fprintf(out, "TTL:           %s\n", state.DescribeTTL(now))
+	fmt.Fprintf(out, "Status:        %s\n", phase)
+	for i, p := range state.NodePools() {
+		if i == 0 {
+			fmt.Fprintln(out, "Node pools:")
+		}
+		if p.MachineType == "" {
+			fmt.Fprintf(out, "  %s: %d nodes\n", p.Name, p.NodeCount)
+		} else {
+			fmt.Fprintf(out, "  %s: %d nodes of type %s\n", p.Name, p.NodeCount, p.MachineType)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package status
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_printStatus(t *testing.T) {
+	now := time.Date(2020, 4, 10, 12, 0, 0, 0, time.UTC)
+	state := &clusters.Cluster{
+		Name:     "my-cluster",
+		Project:  "my-project",
+		Provider: types.GCP,
+		Vars: map[string]interface{}{
+			"location":           "europe-west3-a",
+			"kubernetes_version": "1.15",
+			"node_count":         "3",
+			"machine_type":       "n1-standard-4",
+		},
+		Created: now.Add(-time.Hour),
+		TTL:     72 * time.Hour,
+	}
+
+	out := &bytes.Buffer{}
+	printStatus(out, state, types.Provisioned, now)
+
+	require.Equal(t, `Name:          my-cluster
+Provider:      gcp
+Project:       my-project
+Region:        europe-west3-a
+Kubernetes:    1.15
+Nodes:         3
+Machine type:  n1-standard-4
+Created:       2020-04-10T11:00:00Z
+TTL:           72h0m0s
+Status:        Provisioned
+`, out.String())
+}
+
+func TestNewCmd(t *testing.T) {
+	o := NewOptions(nil)
+	cmd := NewCmd(o)
+
+	require.Error(t, cmd.Args(cmd, []string{}), "the cluster name is required")
+	require.NoError(t, cmd.ParseFlags([]string{"--provider", "azure", "-p", "rg", "-c", "/azure.toml"}))
+	require.Equal(t, types.Azure, o.providerType())
+	require.Equal(t, "rg", o.Project)
+	require.Equal(t, "/azure.toml", o.CredentialsFile)
+}
+
+func Test_eksPhase(t *testing.T) {
+	require.Equal(t, types.Provisioned, eksPhase("ACTIVE"))
+	require.Equal(t, types.Errored, eksPhase("FAILED"))
+	require.Equal(t, types.Phase("DELETING"), eksPhase("DELETING"), "transitional states must be shown as they are")
+}
@@ -0,0 +1,24 @@
+package status
+
+import (
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+//Options defines available options for the command showing the status of a provisioned cluster
+type Options struct {
+	*cli.Options
+
+	Provider        string
+	Project         string
+	CredentialsFile string
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
+
+func (o *Options) providerType() types.ProviderType {
+	return types.ProviderType(o.Provider)
+}
@@ -1,15 +1,36 @@
 package test
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"os"
+	"strings"
+	"time"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/internal/htmlreport"
+	"github.com/kyma-project/cli/internal/junitxml"
+	"github.com/kyma-project/cli/internal/logs"
+	"github.com/kyma-project/cli/internal/testhistory"
 	"github.com/kyma-project/cli/pkg/api/octopus"
-	"github.com/olekukonko/tablewriter"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
 )
 
+// ListPageSize is the number of test suites or test definitions requested at once when listing all of them
+const ListPageSize = 100
+
+// DefaultIgnoredContainers are the containers of testing pods whose logs are not fetched by default
+var DefaultIgnoredContainers = []string{"istio-init", "istio-proxy", "manager"}
+
 func NewTestSuite(name string) *oct.ClusterTestSuite {
 	return &oct.ClusterTestSuite{
 		TypeMeta: metav1.TypeMeta{
@@ -22,19 +43,6 @@ func NewTestSuite(name string) *oct.ClusterTestSuite {
 	}
 }
 
-func NewTableWriter(columns []string, out io.Writer) *tablewriter.Table {
-	writer := tablewriter.NewWriter(out)
-	writer.SetBorder(false)
-	writer.SetHeader(columns)
-	writer.SetAlignment(tablewriter.ALIGN_LEFT)
-	writer.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	writer.SetHeaderLine(false)
-	writer.SetRowSeparator("")
-	writer.SetCenterSeparator("")
-	writer.SetColumnSeparator("")
-	return writer
-}
-
 func GetNumberOfFinishedTests(testSuite *oct.ClusterTestSuite) int {
 	result := 0
 	for _, t := range testSuite.Status.Results {
@@ -45,8 +53,8 @@ func GetNumberOfFinishedTests(testSuite *oct.ClusterTestSuite) int {
 	return result
 }
 
-func ListTestSuitesByName(cli octopus.Interface, names []string) ([]oct.ClusterTestSuite, error) {
-	suites, err := cli.ListTestSuites(metav1.ListOptions{})
+func ListTestSuitesByName(ctx context.Context, cli octopus.Interface, names []string) ([]oct.ClusterTestSuite, error) {
+	suites, err := cli.ListTestSuites(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, errors.Wrap(err, "Unable to list test suites")
 	}
@@ -65,3 +73,119 @@ func ListTestSuitesByName(cli octopus.Interface, names []string) ([]oct.ClusterT
 
 	return result, nil
 }
+
+// MatchTestDefinitions returns the test definitions with the given names, ignoring the case, in the order of the names
+func MatchTestDefinitions(names []string, defs []oct.TestDefinition) ([]oct.TestDefinition, error) {
+	result := []oct.TestDefinition{}
+	for _, name := range names {
+		found := false
+		for _, def := range defs {
+			if strings.EqualFold(name, def.GetName()) {
+				found = true
+				result = append(result, def)
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("test definition '%s' not found in the list of cluster test definitions", name)
+		}
+	}
+	return result, nil
+}
+
+// WaitForTestSuite watches the given test suite until the exitCondition is true or the context is done
+func WaitForTestSuite(ctx context.Context, cli octopus.Interface, name string, exitCondition watchtools.ConditionFunc, timeout time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+
+	preconditionFunc := func(store cache.Store) (bool, error) {
+		_, exists, err := store.Get(&metav1.ObjectMeta{Name: name})
+		if err != nil {
+			return true, err
+		}
+		if !exists {
+			// We need to make sure we see the object in the cache before we start waiting for events
+			// or we would be waiting for the timeout if such object didn't exist.
+			return true, apierrors.NewNotFound(oct.Resource("clustertestsuites"), name)
+		}
+
+		return false, nil
+	}
+
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return cli.ListTestSuites(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return cli.WatchTestSuite(ctx, options)
+		},
+	}
+
+	_, err := watchtools.UntilWithSync(ctx, lw, &oct.ClusterTestSuite{}, preconditionFunc, func(ev watch.Event) (bool, error) {
+		return exitCondition(ev)
+	})
+
+	return err
+}
+
+// ArchiveTestSuite stores the results and logs of a finished test suite in the local test history
+func ArchiveTestSuite(ctx context.Context, cli octopus.Interface, podCli v1.PodsGetter, name string) (string, error) {
+	suite, err := cli.GetTestSuite(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get test suite '%s'", name)
+	}
+
+	run, err := testhistory.NewRun(suite, logs.NewFetcherForTestingPods(podCli, []string{}))
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to archive test suite '%s'", name)
+	}
+
+	store, err := testhistory.DefaultStore()
+	if err != nil {
+		return "", err
+	}
+	return store.Save(run)
+}
+
+// WriteReport writes a "junit" or "html" report of the test suite including the logs of its testing pods.
+// The report is written to the given file, or to the standard output if no file is given.
+func WriteReport(file, format string, suite *oct.ClusterTestSuite, podCli v1.PodsGetter) error {
+	logsFetcher := logs.NewFetcherForTestingPods(podCli, []string{})
+	var write func(out io.Writer) error
+	switch strings.ToLower(format) {
+	case "junit":
+		write = func(out io.Writer) error {
+			return errors.Wrapf(junitxml.NewCreator(logsFetcher).Write(out, suite), "while writing junit report for '%s' test suite", suite.GetName())
+		}
+	case "html":
+		write = func(out io.Writer) error {
+			return errors.Wrapf(htmlreport.NewCreator(logsFetcher).Write(out, suite), "while writing html report for '%s' test suite", suite.GetName())
+		}
+	default:
+		return fmt.Errorf("invalid report format %q: allowed values are: junit, html", format)
+	}
+
+	if file == "" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create report file '%s'", file)
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "Unable to write report file '%s'", file)
+	}
+	fmt.Printf("Report written to '%s'\n", file)
+	return nil
+}
@@ -1,10 +1,11 @@
 package test
 
 import (
+	"context"
 	"testing"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
-	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/kyma-project/cli/pkg/api/octopus/fake"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -36,6 +37,80 @@ func Test_NewTestSuite(t *testing.T) {
 	}
 }
 
+func Test_MatchTestDefinitions(t *testing.T) {
+	testData := []struct {
+		testName        string
+		shouldFail      bool
+		testNames       []string
+		testDefinitions []oct.TestDefinition
+		result          []oct.TestDefinition
+	}{
+		{
+			testName:   "match all tests",
+			shouldFail: false,
+			testNames:  []string{"test1", "test2"},
+			testDefinitions: []oct.TestDefinition{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test1",
+					},
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "",
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test2",
+					},
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "",
+					},
+				},
+			},
+			result: []oct.TestDefinition{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test1",
+					},
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "",
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test2",
+					},
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "",
+					},
+				},
+			},
+		},
+		{
+			testName:   "unknown test",
+			shouldFail: true,
+			testNames:  []string{"test1", "test3"},
+			testDefinitions: []oct.TestDefinition{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test1",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testData {
+		result, err := MatchTestDefinitions(tt.testNames, tt.testDefinitions)
+		if tt.shouldFail {
+			require.NotNil(t, err, tt.testName)
+		} else {
+			require.Nil(t, err, tt.testName)
+			require.Equal(t, result, tt.result, tt.testName)
+		}
+	}
+}
+
 func Test_ListTestSuitesByName(t *testing.T) {
 	testData := []struct {
 		testName        string
@@ -108,8 +183,8 @@ func Test_ListTestSuitesByName(t *testing.T) {
 		},
 	}
 	for _, tt := range testData {
-		mCli := octopus.NewMockedOctopusRestClient(nil, &tt.inputTestSuites, nil)
-		dNames, err := ListTestSuitesByName(mCli, tt.inputNames)
+		mCli := fake.NewSimpleClient(&tt.inputTestSuites)
+		dNames, err := ListTestSuitesByName(context.Background(), mCli, tt.inputNames)
 		if !tt.shouldFail {
 			require.Nil(t, err, tt.testName)
 			require.Equal(t, dNames, tt.expectedResult)
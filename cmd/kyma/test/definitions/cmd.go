@@ -1,8 +1,10 @@
 package definitions
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/kyma-project/cli/cmd/kyma/test"
 	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/internal/kube"
 	"github.com/kyma-project/cli/pkg/api/octopus"
@@ -38,7 +40,7 @@ func (cmd *command) Run() error {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
 	}
 
-	testDefs, err := listTestDefinitionNames(cmd.K8s.Octopus())
+	testDefs, err := listTestDefinitionNames(cmd.Context(), cmd.K8s.Octopus())
 	if err != nil {
 		return err
 	}
@@ -53,8 +55,8 @@ func (cmd *command) Run() error {
 	return nil
 }
 
-func listTestDefinitionNames(cli octopus.Interface) ([]string, error) {
-	defs, err := cli.ListTestDefinitions(metav1.ListOptions{})
+func listTestDefinitionNames(ctx context.Context, cli octopus.Interface) ([]string, error) {
+	defs, err := octopus.ListAllTestDefinitions(ctx, cli, metav1.ListOptions{}, test.ListPageSize)
 	if err != nil {
 		return nil, errors.Wrap(err, "Unable to list test definitions")
 	}
@@ -1,10 +1,11 @@
 package definitions
 
 import (
+	"context"
 	"testing"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
-	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/kyma-project/cli/pkg/api/octopus/fake"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -57,8 +58,8 @@ func Test_ListTestDefinitionNames(t *testing.T) {
 	}
 
 	for _, tt := range testData {
-		mCli := octopus.NewMockedOctopusRestClient(&tt.inputDefinitions, nil, nil)
-		dNames, err := listTestDefinitionNames(mCli)
+		mCli := fake.NewSimpleClient(&tt.inputDefinitions)
+		dNames, err := listTestDefinitionNames(context.Background(), mCli)
 		if !tt.shouldFail {
 			require.Nil(t, err, tt.testName)
 			require.Equal(t, dNames, tt.expectedResult)
@@ -1,6 +1,7 @@
 package del
 
 import (
+	"context"
 	"fmt"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
@@ -33,6 +34,8 @@ Provide at least one test suite name.`,
 		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
 		Aliases: []string{"d"},
 	}
+
+	cobraCmd.Flags().BoolVar(&o.Archive, "archive", false, `Archives the results and logs of the test suites in the local test history before deleting them. Run "kyma test history" to analyze the archived test suites.`)
 	return cobraCmd
 }
 
@@ -54,7 +57,14 @@ func (cmd *command) Run(args []string) error {
 	}
 	testSuites.Items = tSuites
 	for _, ts := range testSuites.Items {
-		if err := deleteTestSuite(cmd.K8s.Octopus(), ts.GetName()); err != nil {
+		if cmd.opts.Archive {
+			path, err := test.ArchiveTestSuite(cmd.Context(), cmd.K8s.Octopus(), cmd.K8s.Static().CoreV1(), ts.GetName())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Test suite '%s' archived to '%s'\n", ts.GetName(), path)
+		}
+		if err := deleteTestSuite(cmd.Context(), cmd.K8s.Octopus(), ts.GetName()); err != nil {
 			return err
 		}
 	}
@@ -62,8 +72,8 @@ func (cmd *command) Run(args []string) error {
 	return nil
 }
 
-func deleteTestSuite(cli octopus.Interface, testName string) error {
-	if err := cli.DeleteTestSuite(test.NewTestSuite(testName).GetName(), metav1.DeleteOptions{}); err != nil {
+func deleteTestSuite(ctx context.Context, cli octopus.Interface, testName string) error {
+	if err := cli.DeleteTestSuite(ctx, test.NewTestSuite(testName).GetName(), metav1.DeleteOptions{}); err != nil {
 		return errors.Wrap(err, fmt.Sprintf("Unable to delete test suite '%s'",
 			testName))
 	}
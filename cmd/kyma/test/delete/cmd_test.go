@@ -1,10 +1,11 @@
 package del
 
 import (
+	"context"
 	"testing"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
-	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/kyma-project/cli/pkg/api/octopus/fake"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -69,8 +70,8 @@ func Test_deleteTestSuite(t *testing.T) {
 	}
 
 	for _, tt := range testData {
-		mCli := octopus.NewMockedOctopusRestClient(nil, tt.testSuitesAvailable, nil)
-		err := deleteTestSuite(mCli, tt.testSuiteNameToDelete)
+		mCli := fake.NewSimpleClient(tt.testSuitesAvailable)
+		err := deleteTestSuite(context.Background(), mCli, tt.testSuiteNameToDelete)
 		if !tt.shouldFail {
 			require.Nil(t, err, tt.testName)
 		} else {
@@ -4,8 +4,9 @@ import "github.com/kyma-project/cli/internal/cli"
 
 type Options struct {
 	*cli.Options
-	Name string
-	All  bool
+	Name    string
+	All     bool
+	Archive bool
 }
 
 func NewOptions(o *cli.Options) *Options {
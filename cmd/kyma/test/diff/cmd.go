@@ -0,0 +1,128 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/testhistory"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+func NewCmd(o *Options) *cobra.Command {
+	cmd := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "diff <test-suite-before> <test-suite-after>",
+		Short: "Compares the results of two test suites.",
+		Long: `Use this command to find out which tests regressed between two test suites, for example before and after an upgrade.
+
+Every argument is either the name of a test suite in the cluster or a file. Supported files are JUnit reports created with ` + "`kyma test status -o junit`" + `, test suites printed with ` + "`kyma test status -o json`" + `, and runs archived in the test history.
+The command reports tests which went from succeeded to failed or back, new and removed tests, and tests whose duration changed significantly.
+If any test regressed, the command exits with a non-zero exit code.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
+	}
+
+	cobraCmd.Flags().StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: json")
+	cobraCmd.Flags().IntVar(&o.DurationThreshold, "duration-threshold", 50, "Change of the test duration in percent from which a test is reported as slower or faster.")
+	cobraCmd.Flags().DurationVar(&o.MinDurationChange, "min-duration-change", 10*time.Second, "Minimum absolute change of the test duration to be reported, so that short tests do not report noise.")
+	return cobraCmd
+}
+
+func (cmd *command) Run(args []string) error {
+	if cmd.opts.DurationThreshold < 0 {
+		return fmt.Errorf("duration threshold must not be negative")
+	}
+
+	before, err := cmd.loadRun(args[0])
+	if err != nil {
+		return err
+	}
+	after, err := cmd.loadRun(args[1])
+	if err != nil {
+		return err
+	}
+
+	changes := testhistory.Compare(*before, *after, testhistory.DiffOptions{
+		DurationThreshold: float64(cmd.opts.DurationThreshold) / 100,
+		MinDurationChange: cmd.opts.MinDurationChange,
+	})
+	if err := printChanges(os.Stdout, changes, cmd.opts.OutputFormat); err != nil {
+		return err
+	}
+
+	if regressions := testhistory.Regressions(changes); len(regressions) > 0 {
+		return cli.NewExitErrorf(cli.ExitCodeFailure, "%d test(s) regressed from '%s' to '%s'", len(regressions), args[0], args[1])
+	}
+	return nil
+}
+
+// loadRun reads a run either from a file or from a finished test suite in the cluster
+func (cmd *command) loadRun(source string) (*testhistory.Run, error) {
+	if isFile(source) {
+		return loadRunFromFile(source)
+	}
+
+	if cmd.K8s == nil {
+		var err error
+		if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
+			return nil, errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
+		}
+	}
+	suite, err := cmd.K8s.Octopus().GetTestSuite(cmd.Context(), source, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get test suite '%s'", source)
+	}
+	return testhistory.NewRun(suite, nil)
+}
+
+func printChanges(out io.Writer, changes []testhistory.Change, outputFormat string) error {
+	switch strings.ToLower(outputFormat) {
+	case "json":
+		d, err := json.MarshalIndent(changes, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "Unable to marshal test changes to json")
+		}
+		fmt.Fprintln(out, string(d))
+	case "":
+		if len(changes) == 0 {
+			fmt.Fprintln(out, "No differences found")
+			return nil
+		}
+		writer := cli.NewTableWriter([]string{"TEST", "CHANGE", "BEFORE", "AFTER"}, out)
+		for _, c := range changes {
+			writer.Append([]string{c.Test, string(c.Type), describe(c.StatusBefore, c.DurationBefore), describe(c.StatusAfter, c.DurationAfter)})
+		}
+		writer.Render()
+	default:
+		return fmt.Errorf("invalid output format %q: allowed values are: json", outputFormat)
+	}
+	return nil
+}
+
+func describe(status string, d time.Duration) string {
+	if status == "" {
+		return "-"
+	}
+	if d <= 0 {
+		return status
+	}
+	return fmt.Sprintf("%s (%s)", status, d.Round(time.Second))
+}
@@ -0,0 +1,84 @@
+package diff
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli/internal/testhistory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadRunFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-diff")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	testData := []struct {
+		testName string
+		file     string
+		content  string
+		expected testhistory.Run
+	}{
+		{
+			testName: "JUnit report",
+			file:     "report.xml",
+			content: `<testsuites><testsuite tests="2" failures="1" time="90.0" name="testing">
+<testcase classname="octopus" name="[testing] kyma-system/core (executions: 2)" time="60.500000"><failure message="Failed" type=""></failure></testcase>
+<testcase classname="octopus" name="[testing] kyma-system/kiali" time="0.000000"><skipped message="Test was skipped."></skipped></testcase>
+</testsuite></testsuites>`,
+			expected: testhistory.Run{Suite: "testing", Results: []testhistory.Result{
+				{Name: "core", Namespace: "kyma-system", Status: "Failed", Executions: []testhistory.Execution{{Duration: 60500 * time.Millisecond}}},
+				{Name: "kiali", Namespace: "kyma-system", Status: "Skipped"},
+			}},
+		},
+		{
+			testName: "test suite in JSON format",
+			file:     "suite.json",
+			content: `{"kind": "ClusterTestSuite", "metadata": {"name": "testing"}, "status": {
+"conditions": [{"type": "Succeeded", "status": "True"}],
+"results": [{"name": "core", "namespace": "kyma-system", "status": "Succeeded", "executions": [{"id": "core-0", "podPhase": "Succeeded"}]}]}}`,
+			expected: testhistory.Run{Suite: "testing", Status: "Succeeded", Results: []testhistory.Result{
+				{Name: "core", Namespace: "kyma-system", Status: "Succeeded", Executions: []testhistory.Execution{{ID: "core-0", PodPhase: "Succeeded"}}},
+			}},
+		},
+		{
+			testName: "archived run",
+			file:     "archived.json",
+			content:  `{"suite": "testing", "status": "Failed", "results": [{"name": "core", "namespace": "kyma-system", "status": "Failed"}]}`,
+			expected: testhistory.Run{Suite: "testing", Status: "Failed", Results: []testhistory.Result{
+				{Name: "core", Namespace: "kyma-system", Status: "Failed"},
+			}},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.testName, func(t *testing.T) {
+			path := filepath.Join(dir, tt.file)
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.content), 0600))
+			require.True(t, isFile(path))
+
+			run, err := loadRunFromFile(path)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, *run)
+		})
+	}
+}
+
+func Test_printChanges(t *testing.T) {
+	out := &bytes.Buffer{}
+	require.NoError(t, printChanges(out, []testhistory.Change{
+		{Test: "kyma-system/core", Type: testhistory.Regressed, StatusBefore: "Succeeded", StatusAfter: "Failed", DurationBefore: time.Minute},
+	}, ""))
+	require.Contains(t, out.String(), "Succeeded (1m0s)")
+
+	out.Reset()
+	require.NoError(t, printChanges(out, nil, ""))
+	require.Equal(t, "No differences found\n", out.String())
+
+	require.Error(t, printChanges(out, nil, "yaml"))
+}
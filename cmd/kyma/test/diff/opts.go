@@ -0,0 +1,18 @@
+package diff
+
+import (
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+type Options struct {
+	*cli.Options
+	OutputFormat      string
+	DurationThreshold int
+	MinDurationChange time.Duration
+}
+
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
@@ -0,0 +1,98 @@
+package diff
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/internal/junitxml"
+	"github.com/kyma-project/cli/internal/testhistory"
+	"github.com/pkg/errors"
+)
+
+// isFile tells if the source of a run is a local file instead of a test suite in the cluster
+func isFile(source string) bool {
+	info, err := os.Stat(source)
+	return err == nil && !info.IsDir()
+}
+
+// loadRunFromFile reads a run from a JUnit XML report, an archived run or a test suite in JSON format
+func loadRunFromFile(path string) (*testhistory.Run, error) {
+	if strings.EqualFold(filepath.Ext(path), ".xml") {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to open '%s'", path)
+		}
+		defer f.Close()
+
+		suites, err := junitxml.Parse(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read '%s'", path)
+		}
+		return runFromJUnit(suites), nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read '%s'", path)
+	}
+
+	// test suites printed with `kyma test status -o json` have a kind, archived runs do not
+	meta := struct {
+		Kind string `json:"kind"`
+	}{}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse '%s'", path)
+	}
+	if meta.Kind == "ClusterTestSuite" {
+		suite := &oct.ClusterTestSuite{}
+		if err := json.Unmarshal(data, suite); err != nil {
+			return nil, errors.Wrapf(err, "unable to parse test suite from '%s'", path)
+		}
+		return testhistory.NewRun(suite, nil)
+	}
+
+	run := &testhistory.Run{}
+	if err := json.Unmarshal(data, run); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse archived run from '%s'", path)
+	}
+	return run, nil
+}
+
+// runFromJUnit converts the test cases of a JUnit report created by `kyma test status -o junit` into a run
+func runFromJUnit(suites junitxml.JUnitTestSuites) *testhistory.Run {
+	run := &testhistory.Run{}
+	for _, s := range suites.Suites {
+		if run.Suite == "" {
+			run.Suite = s.Name
+		}
+		for _, tc := range s.TestCases {
+			// test case names look like "[testing] <namespace>/<name> (executions: <n>)"
+			name := strings.TrimPrefix(tc.Name, "[testing] ")
+			if i := strings.Index(name, " (executions:"); i >= 0 {
+				name = name[:i]
+			}
+			result := testhistory.Result{Name: name, Status: string(oct.TestSucceeded)}
+			if i := strings.Index(name, "/"); i >= 0 {
+				result.Namespace, result.Name = name[:i], name[i+1:]
+			}
+
+			switch {
+			case tc.SkipMessage != nil:
+				result.Status = string(oct.TestSkipped)
+			case tc.Failure != nil:
+				result.Status = string(oct.TestFailed)
+			}
+			if seconds, err := strconv.ParseFloat(tc.Time, 64); err == nil && seconds > 0 {
+				result.Executions = []testhistory.Execution{{Duration: time.Duration(seconds * float64(time.Second))}}
+			}
+			run.Results = append(run.Results, result)
+		}
+	}
+	return run
+}
@@ -0,0 +1,105 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/testhistory"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+func NewCmd(o *Options) *cobra.Command {
+	cmd := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows statistics of the archived test suites.",
+		Long: `Use this command to analyze the test suites archived in the local test history.
+
+To archive a test suite, run ` + "`kyma test run --watch --archive`" + ` or ` + "`kyma test delete --archive <test-suite>`" + `.
+The command shows the pass rate and the average duration of every test across all archived runs. Tests which passed only after being retried with the "--max-retries" flag are marked as flaky.
+To export the statistics, run ` + "`kyma test history -o csv`" + ` or ` + "`kyma test history -o json`" + `.
+`,
+		RunE: func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+
+	cobraCmd.Flags().StringVarP(&o.OutputFormat, "output", "o", "", "Output format. One of: csv|json")
+	cobraCmd.Flags().BoolVar(&o.FlakyOnly, "flaky", false, "Shows only flaky tests.")
+	return cobraCmd
+}
+
+func (cmd *command) Run() error {
+	store, err := testhistory.DefaultStore()
+	if err != nil {
+		return err
+	}
+
+	runs, err := store.Runs()
+	if err != nil {
+		return errors.Wrap(err, "unable to read the test history")
+	}
+	if len(runs) == 0 {
+		fmt.Println("No archived test suites found")
+		return nil
+	}
+
+	stats := testhistory.Analyze(runs)
+	if cmd.opts.FlakyOnly {
+		stats = flaky(stats)
+	}
+
+	return printStats(os.Stdout, runs, stats, cmd.opts.OutputFormat)
+}
+
+func printStats(out io.Writer, runs []testhistory.Run, stats []testhistory.TestStats, outputFormat string) error {
+	switch strings.ToLower(outputFormat) {
+	case "csv":
+		return testhistory.WriteCSV(out, stats)
+	case "json":
+		d, err := json.MarshalIndent(stats, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "Unable to marshal test statistics to json")
+		}
+		fmt.Fprintln(out, string(d))
+	case "":
+		fmt.Fprintf(out, "Archived runs:\t%d (%s - %s)\r\n", len(runs),
+			runs[0].CompletionTime.Format(time.RFC3339), runs[len(runs)-1].CompletionTime.Format(time.RFC3339))
+		writer := cli.NewTableWriter([]string{"TEST", "RUNS", "PASS RATE", "AVG DURATION", "FLAKY"}, out)
+		for _, s := range stats {
+			flakyRuns := "No"
+			if s.IsFlaky() {
+				flakyRuns = fmt.Sprintf("Yes - %d run(s)", s.Flaky)
+			}
+			writer.Append([]string{s.Name, fmt.Sprint(s.Runs), fmt.Sprintf("%.0f%%", s.PassRate*100), s.AvgDuration.Round(time.Second).String(), flakyRuns})
+		}
+		writer.Render()
+	default:
+		return fmt.Errorf("invalid output format %q: allowed values are: csv, json", outputFormat)
+	}
+
+	return nil
+}
+
+func flaky(stats []testhistory.TestStats) []testhistory.TestStats {
+	var result []testhistory.TestStats
+	for _, s := range stats {
+		if s.IsFlaky() {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package history
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli/internal/testhistory"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_printStats(t *testing.T) {
+	runs := []testhistory.Run{{Suite: "suite", CompletionTime: time.Date(2020, 2, 1, 10, 0, 0, 0, time.UTC)}}
+	stats := []testhistory.TestStats{
+		{Name: "api", Runs: 4, Passed: 4, Flaky: 1, PassRate: 1, AvgDuration: 80 * time.Second},
+		{Name: "ui", Runs: 4, Passed: 2, Failed: 2, PassRate: 0.5, AvgDuration: 20 * time.Second},
+	}
+
+	out := &bytes.Buffer{}
+	require.NoError(t, printStats(out, runs, stats, ""))
+	require.Contains(t, out.String(), "Yes - 1 run(s)")
+	require.Contains(t, out.String(), "50%")
+
+	out.Reset()
+	require.NoError(t, printStats(out, runs, stats, "json"))
+	require.Contains(t, out.String(), `"passRate": 0.5`)
+
+	out.Reset()
+	require.NoError(t, printStats(out, runs, stats, "csv"))
+	require.Contains(t, out.String(), "ui,4,2,2,0,0.50,20.0")
+
+	require.Error(t, printStats(out, runs, stats, "yaml"), "Unsupported output formats must fail.")
+	require.Len(t, flaky(stats), 1)
+}
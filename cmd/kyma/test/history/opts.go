@@ -0,0 +1,13 @@
+package history
+
+import "github.com/kyma-project/cli/internal/cli"
+
+type Options struct {
+	*cli.Options
+	OutputFormat string
+	FlakyOnly    bool
+}
+
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/cli/cmd/kyma/test"
 	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/pkg/api/octopus"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,7 +40,7 @@ func (cmd *command) Run() error {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure that your kubeconfig is valid.")
 	}
 
-	testSuites, err := cmd.K8s.Octopus().ListTestSuites(metav1.ListOptions{})
+	testSuites, err := octopus.ListAllTestSuites(cmd.Context(), cmd.K8s.Octopus(), metav1.ListOptions{}, test.ListPageSize)
 	if err != nil {
 		return errors.Wrap(err, "Unable to get list of test suites")
 	}
@@ -49,7 +50,7 @@ func (cmd *command) Run() error {
 		return nil
 	}
 
-	writer := test.NewTableWriter([]string{"TEST SUITE", "COMPLETED", "STATUS"}, os.Stdout)
+	writer := cli.NewTableWriter([]string{"TEST SUITE", "COMPLETED", "STATUS"}, os.Stdout)
 
 	for idx := range testSuites.Items {
 		ts := testSuites.Items[idx]
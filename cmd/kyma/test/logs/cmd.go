@@ -2,9 +2,12 @@ package logs
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"github.com/kyma-project/cli/internal/logs"
+	"os"
 	"strings"
+	"time"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
 	"github.com/kyma-project/cli/cmd/kyma/test"
@@ -12,11 +15,14 @@ import (
 	"github.com/kyma-project/cli/internal/kube"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 var (
-	defaultIgnoredContainers = []string{"istio-init", "istio-proxy", "manager"}
-	defaultLogsInStatus      = string(oct.TestFailed)
+	defaultLogsInStatus = string(oct.TestFailed)
+	// streamGracePeriod is how long the log streams may continue after the test suite is finished
+	streamGracePeriod = 30 * time.Second
 )
 
 type command struct {
@@ -37,13 +43,16 @@ func NewCmd(o *Options) *cobra.Command {
 
 To print the status of specific test cases, run ` + "`kyma test logs testSuiteOne testSuiteTwo`" + `.
 Provide at least one test suite name.
+
+To stream the logs of a running test suite, run ` + "`kyma test logs testSuiteOne --follow`" + `. The command attaches to every testing Pod as soon as it starts and prefixes each log line with the test and container name. It stops when the test suite completes.
 `,
 
 		RunE: func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
 	}
 
 	cobraCmd.Flags().StringVar(&o.InStatus, "test-status", defaultLogsInStatus, "Displays logs coming only from testing Pods with a given status.")
-	cobraCmd.Flags().StringSliceVar(&o.IngoredContainers, "ignored-containers", defaultIgnoredContainers, "Container names which are ignored when fetching logs from testing Pods. Takes comma-separated list.")
+	cobraCmd.Flags().StringSliceVar(&o.IngoredContainers, "ignored-containers", test.DefaultIgnoredContainers, "Container names which are ignored when fetching logs from testing Pods. Takes comma-separated list.")
+	cobraCmd.Flags().BoolVarP(&o.Follow, "follow", "f", false, "Streams the logs of all testing Pods of a single test suite until the test suite completes. The \"test-status\" flag is ignored.")
 
 	return cobraCmd
 }
@@ -56,6 +65,9 @@ func (cmd *command) Run(args []string) error {
 	if len(args) < 1 {
 		return fmt.Errorf("Test suite name required")
 	}
+	if cmd.opts.Follow && len(args) > 1 {
+		return fmt.Errorf("Logs can be followed for only one test suite at a time")
+	}
 
 	var err error
 	cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath)
@@ -63,10 +75,14 @@ func (cmd *command) Run(args []string) error {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure that your kubeconfig is valid.")
 	}
 
+	if cmd.opts.Follow {
+		return cmd.follow(args[0])
+	}
+
 	logsStep := cmd.NewStep("Fetching logs")
 	logsStep.Start()
 
-	testsList, err := test.ListTestSuitesByName(cmd.K8s.Octopus(), args)
+	testsList, err := test.ListTestSuitesByName(cmd.Context(), cmd.K8s.Octopus(), args)
 	if err != nil {
 		return errors.Wrap(err, "unable to list test suites")
 	}
@@ -99,6 +115,56 @@ func (cmd *command) Run(args []string) error {
 	return nil
 }
 
+// follow watches the test suite and streams the logs of its testing pods until the suite completes
+func (cmd *command) follow(suiteName string) error {
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+	follower := logs.NewFollowerForTestingPods(cmd.K8s.Static().CoreV1(), cmd.opts.IngoredContainers, os.Stdout)
+
+	err := test.WaitForTestSuite(ctx, cmd.K8s.Octopus(), suiteName, func(event watch.Event) (bool, error) {
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			suite, ok := event.Object.(*oct.ClusterTestSuite)
+			if !ok {
+				return false, fmt.Errorf("internal error: unexpected object %#v", event.Object)
+			}
+			follower.Follow(ctx, suite)
+			return testSuiteFinished(suite), nil
+		case watch.Deleted:
+			return false, apierrors.NewNotFound(oct.Resource("clustertestsuites"), suiteName)
+		default:
+			return true, fmt.Errorf("internal error: unexpected event %#v", event)
+		}
+	}, 0)
+
+	if err != nil {
+		// there is nothing to follow anymore, stop the log streams
+		cancel()
+	}
+	// the log streams end on their own once the containers terminate
+	streamErr := follower.Wait(streamGracePeriod)
+	if cmd.Interrupted() {
+		return fmt.Errorf("Stopped following the logs of test suite '%s'", suiteName)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while watching test suite '%s'", suiteName)
+	}
+	return errors.Wrap(streamErr, "while following logs")
+}
+
+func testSuiteFinished(suite *oct.ClusterTestSuite) bool {
+	for _, cond := range suite.Status.Conditions {
+		if cond.Status != oct.StatusTrue {
+			continue
+		}
+		switch cond.Type {
+		case oct.SuiteSucceeded, oct.SuiteFailed, oct.SuiteError:
+			return true
+		}
+	}
+	return false
+}
+
 func filterResultsByStatus(testsList []oct.ClusterTestSuite, status string) []oct.TestResult {
 	var results []oct.TestResult
 	for _, t := range testsList {
@@ -8,6 +8,7 @@ type Options struct {
 	*cli.Options
 	InStatus          string
 	IngoredContainers []string
+	Follow            bool
 }
 
 func NewOptions(o *cli.Options) *Options {
@@ -11,17 +11,14 @@ import (
 	"github.com/kyma-project/cli/cmd/kyma/test"
 	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/logs"
 	"github.com/kyma-project/cli/pkg/api/octopus"
 	"github.com/kyma-project/cli/pkg/step"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
-	"k8s.io/client-go/tools/cache"
-	watchtools "k8s.io/client-go/tools/watch"
 )
 
 type command struct {
@@ -44,6 +41,15 @@ Remarks:
 If you don't provide any specific test definitions, all available test definitions will be added to the newly created test suite.
 To execute all test defintions, run ` + "`kyma test run -n example-test`" + `.
 
+To run a subset of test definitions, select them by labels, components, or regular expressions on their names. For example:
+- ` + "`kyma test run --selector app=api-gateway --selector 'team in (huskies, tunas)'`" + ` runs test definitions matching at least one of the label selectors.
+- ` + "`kyma test run --component serverless`" + ` runs test definitions labeled with ` + "`" + componentLabel + "=serverless`" + `.
+- ` + "`kyma test run --match '^core-' --exclude 'upgrade'`" + ` runs test definitions whose names start with "core-", except for the ones containing "upgrade".
+The "--selector" and "--component" flags add up: a test definition is selected if it matches at least one of the given selectors or components. The "--match" and "--exclude" flags then narrow down this selection by name.
+
+To use the command as a CI step, run ` + "`kyma test run --wait --report junit --report-file report.xml --logs-on-failure --delete-after`" + `.
+With the "--wait" flag, the command exits with the code 0 if all tests succeeded, 1 if tests failed, and 2 on a timeout or an infrastructure error.
+
 `,
 		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
 		Aliases: []string{"r"},
@@ -54,22 +60,41 @@ To execute all test defintions, run ` + "`kyma test run -n example-test`" + `.
 	cobraCmd.Flags().Int64VarP(&o.MaxRetries, "max-retries", "", 0, `Number of times a given test is retried when it fails. A suite is marked with a "succeeded" status even if some tests failed at first and then finally succeeded. The default value of 0 means that there are no retries of a given test.`)
 	cobraCmd.Flags().Int64VarP(&o.Concurrency, "concurrency", "", 5, "Number of tests to be executed in parallel.")
 	cobraCmd.Flags().DurationVar(&o.Timeout, "timeout", 0, `Maximum time during which the test suite is being watched, where "0" means "infinite". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".`)
+	cobraCmd.Flags().StringArrayVar(&o.Selectors, "selector", nil, `Label selector for the test definitions to run. Repeat the flag to run test definitions matching at least one of several selectors. Takes the Kubernetes label selector syntax, such as "key=value" or "key in (a, b)".`)
+	cobraCmd.Flags().StringSliceVar(&o.Components, "component", nil, `Kyma components whose test definitions are run. It is a shortcut for the "--selector `+componentLabel+`=<component>" flag.`)
+	cobraCmd.Flags().StringVar(&o.Match, "match", "", "Regular expression which the names of the test definitions to run must match.")
+	cobraCmd.Flags().StringVar(&o.Exclude, "exclude", "", "Regular expression for the names of test definitions which are not run.")
 	cobraCmd.Flags().BoolVarP(&o.Watch, "watch", "w", false, `Watches the status of the test suite until the tests finish or the defined "--timeout" occurs.`)
+	cobraCmd.Flags().BoolVar(&o.Wait, "wait", false, `Waits until the tests finish or the defined "--timeout" occurs, and exits with the code 0 if all tests succeeded, 1 if tests failed, and 2 on a timeout or an infrastructure error.`)
+	cobraCmd.Flags().BoolVar(&o.Archive, "archive", false, `Archives the results and logs of the finished test suite in the local test history. Requires the "--watch" or "--wait" flag. Run "kyma test history" to analyze the archived test suites.`)
+	cobraCmd.Flags().StringVar(&o.Report, "report", "", `Format of the report created when the test suite finishes. One of: junit|html. Requires the "--wait" flag.`)
+	cobraCmd.Flags().StringVar(&o.ReportFile, "report-file", "", `File to which the report is written. By default, the report is printed to the standard output. Requires the "--report" flag.`)
+	cobraCmd.Flags().BoolVar(&o.LogsOnFailure, "logs-on-failure", false, `Prints the logs of the failed tests when the test suite finishes. Requires the "--wait" flag.`)
+	cobraCmd.Flags().BoolVar(&o.DeleteAfter, "delete-after", false, `Deletes the test suite when it finishes. Requires the "--wait" flag.`)
 	return cobraCmd
 }
 
 func (cmd *command) Run(args []string) error {
+	if err := cmd.validateFlags(); err != nil {
+		return err
+	}
+
 	var err error
-	if cmd.opts.Watch {
+	if cmd.opts.Watch || cmd.opts.Wait {
 		if cmd.K8s, err = kube.NewFromConfigWithTimeout("", cmd.KubeconfigPath, cmd.opts.Timeout); err != nil {
-			return errors.Wrapf(err, "Could not initialize the Kubernetes client with %d timeout. Make sure your kubeconfig is valid.", cmd.opts.Timeout)
+			return cmd.exitError(errors.Wrapf(err, "Could not initialize the Kubernetes client with %d timeout. Make sure your kubeconfig is valid.", cmd.opts.Timeout))
 		}
 	} else {
 		if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
-			return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
+			return cmd.exitError(errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid."))
 		}
 	}
 
+	filter, err := newTestFilter(cmd.opts.Selectors, cmd.opts.Components, cmd.opts.Match, cmd.opts.Exclude)
+	if err != nil {
+		return err
+	}
+
 	var testSuiteName string
 	if len(cmd.opts.Name) > 0 {
 		testSuiteName = cmd.opts.Name
@@ -79,67 +104,181 @@ func (cmd *command) Run(args []string) error {
 		testSuiteName = fmt.Sprintf("test-%d", rnd)
 	}
 
-	tNotExists, err := verifyIfTestNotExists(testSuiteName, cmd.K8s.Octopus())
+	ctx := cmd.Context()
+	testResource, err := cmd.createTestSuite(ctx, cmd.K8s.Octopus(), testSuiteName, filter, args)
 	if err != nil {
 		return err
 	}
+	fmt.Printf("- Test suite '%s' successfully created\r\n", testSuiteName)
+
+	if !cmd.opts.Watch && !cmd.opts.Wait {
+		return nil
+	}
+
+	waitStep := cmd.NewStep("Waiting for test suite to finish")
+	err = test.WaitForTestSuite(ctx, cmd.K8s.Octopus(), testResource.Name, clusterTestSuiteCompleted(waitStep), cmd.opts.Timeout)
+	if err != nil {
+		waitStep.Failure()
+		if cmd.Interrupted() {
+			return fmt.Errorf("Stopped watching test suite '%s'. The test suite keeps running in the cluster, run `kyma test status %s` to check its status", testSuiteName, testSuiteName)
+		}
+		return cmd.exitError(err)
+	}
+
+	return cmd.finish(ctx, testSuiteName)
+}
+
+// createTestSuite creates the test suite of the test definitions selected by the arguments and the filter
+func (cmd *command) createTestSuite(ctx context.Context, cli octopus.Interface, testSuiteName string, filter *testFilter, args []string) (*oct.ClusterTestSuite, error) {
+	tNotExists, err := verifyIfTestNotExists(ctx, testSuiteName, cli)
+	if err != nil {
+		return nil, cmd.exitError(err)
+	}
 	if !tNotExists {
-		return fmt.Errorf("Test suite '%s' already exists", testSuiteName)
+		return nil, fmt.Errorf("Test suite '%s' already exists", testSuiteName)
 	}
 
-	clusterTestDefs, err := cmd.K8s.Octopus().ListTestDefinitions(metav1.ListOptions{})
+	clusterTestDefs, err := cli.ListTestDefinitions(ctx, metav1.ListOptions{})
 	if err != nil {
-		return errors.Wrap(err, "Unable to get the list of test definitions")
+		return nil, cmd.exitError(errors.Wrap(err, "Unable to get the list of test definitions"))
 	}
 
 	var testDefToApply []oct.TestDefinition
 	if len(args) == 0 {
 		testDefToApply = clusterTestDefs.Items
 	} else {
-		if testDefToApply, err = matchTestDefinitionNames(args,
+		if testDefToApply, err = test.MatchTestDefinitions(args,
 			clusterTestDefs.Items); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	if testDefToApply = filter.apply(testDefToApply); len(testDefToApply) == 0 {
+		return nil, errors.New("No test definitions match the given selectors and patterns")
+	}
+
 	testResource := generateTestsResource(testSuiteName,
 		cmd.opts.ExecutionCount, cmd.opts.MaxRetries,
 		cmd.opts.Concurrency, testDefToApply)
+	if len(args) == 0 && filter.labelsOnly() {
+		// let Octopus resolve the label selectors, so that the suite reflects how the tests were selected
+		testResource.Spec.Selectors = oct.TestsSelector{MatchLabelExpressions: filter.expressions}
+	}
 
-	if _, err := cmd.K8s.Octopus().CreateTestSuite(testResource); err != nil {
-		return err
+	if _, err := cli.CreateTestSuite(ctx, testResource); err != nil {
+		return nil, cmd.exitError(errors.Wrapf(err, "unable to create test suite '%s'", testSuiteName))
 	}
-	fmt.Printf("- Test suite '%s' successfully created\r\n", testSuiteName)
+	return testResource, nil
+}
 
-	if cmd.opts.Watch {
-		waitStep := cmd.NewStep("Waiting for test suite to finish")
-		err = waitForTestSuite(cmd.K8s.Octopus(), testResource.Name, clusterTestSuiteCompleted(waitStep), cmd.opts.Timeout)
+// finish runs all actions requested for the finished test suite and, when waiting, turns its result into the exit code
+func (cmd *command) finish(ctx context.Context, testSuiteName string) error {
+	if cmd.opts.Archive {
+		path, err := test.ArchiveTestSuite(ctx, cmd.K8s.Octopus(), cmd.K8s.Static().CoreV1(), testSuiteName)
 		if err != nil {
-			waitStep.Failure()
-			return err
+			return cmd.exitError(err)
+		}
+		fmt.Printf("- Test suite '%s' archived to '%s'\r\n", testSuiteName, path)
+	}
+	if !cmd.opts.Wait {
+		return nil
+	}
+
+	suite, err := cmd.K8s.Octopus().GetTestSuite(ctx, testSuiteName, metav1.GetOptions{})
+	if err != nil {
+		return cmd.exitError(errors.Wrapf(err, "unable to get test suite '%s'", testSuiteName))
+	}
+
+	if cmd.opts.Report != "" {
+		if err := test.WriteReport(cmd.opts.ReportFile, cmd.opts.Report, suite, cmd.K8s.Static().CoreV1()); err != nil {
+			return cmd.exitError(err)
+		}
+	}
+
+	if cmd.opts.LogsOnFailure {
+		if err := printFailedTestsLogs(suite, logs.NewFetcherForTestingPods(cmd.K8s.Static().CoreV1(), test.DefaultIgnoredContainers)); err != nil {
+			return cmd.exitError(err)
+		}
+	}
+
+	if cmd.opts.DeleteAfter {
+		if err := cmd.K8s.Octopus().DeleteTestSuite(ctx, testSuiteName, metav1.DeleteOptions{}); err != nil {
+			return cmd.exitError(errors.Wrapf(err, "unable to delete test suite '%s'", testSuiteName))
 		}
+		fmt.Printf("- Test suite '%s' successfully deleted\r\n", testSuiteName)
+	}
+
+	return suiteExitError(suite)
+}
+
+// exitError marks errors with the infrastructure error exit code when waiting for the test suite
+func (cmd *command) exitError(err error) error {
+	if !cmd.opts.Wait {
+		return err
 	}
+	return cli.NewExitError(cli.ExitCodeError, err)
+}
 
+func (cmd *command) validateFlags() error {
+	if cmd.opts.Archive && !cmd.opts.Watch && !cmd.opts.Wait {
+		return fmt.Errorf(`The "--archive" flag requires the "--watch" or "--wait" flag`)
+	}
+	if !cmd.opts.Wait {
+		switch {
+		case cmd.opts.Report != "":
+			return fmt.Errorf(`The "--report" flag requires the "--wait" flag`)
+		case cmd.opts.LogsOnFailure:
+			return fmt.Errorf(`The "--logs-on-failure" flag requires the "--wait" flag`)
+		case cmd.opts.DeleteAfter:
+			return fmt.Errorf(`The "--delete-after" flag requires the "--wait" flag`)
+		}
+	}
+	if cmd.opts.ReportFile != "" && cmd.opts.Report == "" {
+		return fmt.Errorf(`The "--report-file" flag requires the "--report" flag`)
+	}
+	switch strings.ToLower(cmd.opts.Report) {
+	case "", "junit", "html":
+	default:
+		return fmt.Errorf("invalid report format %q: allowed values are: junit, html", cmd.opts.Report)
+	}
 	return nil
 }
 
-func matchTestDefinitionNames(testNames []string,
-	testDefs []oct.TestDefinition) ([]oct.TestDefinition, error) {
-	result := []oct.TestDefinition{}
-	for _, tName := range testNames {
-		found := false
-		for _, tDef := range testDefs {
-			if strings.EqualFold(tName, tDef.GetName()) {
-				found = true
-				result = append(result, tDef)
-				break
-			}
+// suiteExitError returns the error which makes the CLI exit with the code matching the result of the test suite
+func suiteExitError(suite *oct.ClusterTestSuite) error {
+	for _, cond := range suite.Status.Conditions {
+		if cond.Status != oct.StatusTrue {
+			continue
 		}
-		if !found {
-			return nil, fmt.Errorf("test definition '%s' not found in the list of cluster test definitions", tName)
+		switch cond.Type {
+		case oct.SuiteSucceeded:
+			return nil
+		case oct.SuiteFailed:
+			return cli.NewExitErrorf(cli.ExitCodeFailure, "Test suite '%s' failed, run `kyma test status %s` to see the failed tests", suite.Name, suite.Name)
+		case oct.SuiteError:
+			return cli.NewExitErrorf(cli.ExitCodeError, "Test suite '%s' could not be executed", suite.Name)
 		}
 	}
-	return result, nil
+	return cli.NewExitErrorf(cli.ExitCodeError, "Test suite '%s' did not finish", suite.Name)
+}
+
+// logsFetcher allows you to fetch logs from the testing pods
+type logsFetcher interface {
+	Logs(result oct.TestResult) (string, error)
+}
+
+func printFailedTestsLogs(suite *oct.ClusterTestSuite, fetcher logsFetcher) error {
+	for _, r := range suite.Status.Results {
+		if r.Status != oct.TestFailed {
+			continue
+		}
+		out, err := fetcher.Logs(r)
+		if err != nil {
+			return errors.Wrapf(err, "while fetching logs of test '%s'", r.Name)
+		}
+		fmt.Printf("- Logs of failed test '%s':\r\n%s", r.Name, out)
+	}
+	return nil
 }
 
 func generateTestsResource(testName string, numberOfExecutions,
@@ -162,8 +301,8 @@ func generateTestsResource(testName string, numberOfExecutions,
 	return octTestDefs
 }
 
-func listTestSuiteNames(cli octopus.Interface) ([]string, error) {
-	suites, err := cli.ListTestSuites(metav1.ListOptions{})
+func listTestSuiteNames(ctx context.Context, cli octopus.Interface) ([]string, error) {
+	suites, err := cli.ListTestSuites(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, errors.Wrap(err, "Unable to list test suites")
 	}
@@ -175,9 +314,9 @@ func listTestSuiteNames(cli octopus.Interface) ([]string, error) {
 	return result, nil
 }
 
-func verifyIfTestNotExists(suiteName string,
+func verifyIfTestNotExists(ctx context.Context, suiteName string,
 	cli octopus.Interface) (bool, error) {
-	tests, err := listTestSuiteNames(cli)
+	tests, err := listTestSuiteNames(ctx, cli)
 	if err != nil {
 		return false, err
 	}
@@ -189,49 +328,6 @@ func verifyIfTestNotExists(suiteName string,
 	return true, nil
 }
 
-// waitForTestSuite watches the given test suite until the exitCondition is true
-func waitForTestSuite(cli octopus.Interface, name string, exitCondition watchtools.ConditionFunc, timeout time.Duration) error {
-	ctx, cancel := context.WithCancel(context.Background())
-	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
-	}
-	defer cancel()
-
-	preconditionFunc := func(store cache.Store) (bool, error) {
-		_, exists, err := store.Get(&metav1.ObjectMeta{Name: name})
-		if err != nil {
-			return true, err
-		}
-		if !exists {
-			// We need to make sure we see the object in the cache before we start waiting for events
-			// or we would be waiting for the timeout if such object didn't exist.
-			return true, apierrors.NewNotFound(oct.Resource("clustertestsuites"), name)
-		}
-
-		return false, nil
-	}
-
-	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
-	lw := &cache.ListWatch{
-		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
-			options.FieldSelector = fieldSelector
-			return cli.ListTestSuites(options)
-		},
-		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
-			options.FieldSelector = fieldSelector
-			return cli.WatchTestSuite(options)
-		},
-	}
-
-	// TODO(mszostok): use the `interrupt.New(nil, cancel)` func from the
-	//  `k8s.io/kubectl/pkg/util/interrupt` pkg when switching to k8s 1.16
-	_, err := watchtools.UntilWithSync(ctx, lw, &oct.ClusterTestSuite{}, preconditionFunc, func(ev watch.Event) (bool, error) {
-		return exitCondition(ev)
-	})
-
-	return err
-}
-
 // clusterTestSuiteCompleted returns true if the suite has run to completion, false if the suite has not yet
 // reached running state, or an error in any other case.
 func clusterTestSuiteCompleted(statusReporter step.Step) func(event watch.Event) (bool, error) {
@@ -1,80 +1,22 @@
 package run
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/cmd/kyma/test"
+	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/kyma-project/cli/pkg/api/octopus/fake"
 	"github.com/kyma-project/cli/pkg/step/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/watch"
 )
 
-func Test_matchTestDefinitionNames(t *testing.T) {
-	testData := []struct {
-		testName        string
-		shouldFail      bool
-		testNames       []string
-		testDefinitions []oct.TestDefinition
-		result          []oct.TestDefinition
-	}{
-		{
-			testName:   "match all tests",
-			shouldFail: false,
-			testNames:  []string{"test1", "test2"},
-			testDefinitions: []oct.TestDefinition{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test1",
-					},
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: "",
-					},
-				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test2",
-					},
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: "",
-					},
-				},
-			},
-			result: []oct.TestDefinition{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test1",
-					},
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: "",
-					},
-				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "test2",
-					},
-					TypeMeta: metav1.TypeMeta{
-						APIVersion: "",
-					},
-				},
-			},
-		},
-	}
-
-	for _, tt := range testData {
-		result, err := matchTestDefinitionNames(tt.testNames, tt.testDefinitions)
-		if tt.shouldFail {
-			require.NotNil(t, err, tt.testName)
-		} else {
-			require.Nil(t, err, tt.testName)
-			require.Equal(t, result, tt.result, tt.testName)
-		}
-	}
-}
-
 func Test_generateTestsResource(t *testing.T) {
 	testData := []struct {
 		testName             string
@@ -202,10 +144,10 @@ func Test_verifyIfTestNotExists(t *testing.T) {
 	}
 
 	for _, tt := range testData {
-		mCli := octopus.NewMockedOctopusRestClient(nil, &oct.ClusterTestSuiteList{
+		mCli := fake.NewSimpleClient(&oct.ClusterTestSuiteList{
 			Items: tt.inputSuites,
-		}, nil)
-		tExists, _ := verifyIfTestNotExists(tt.inputSuiteName, mCli)
+		})
+		tExists, _ := verifyIfTestNotExists(context.Background(), tt.inputSuiteName, mCli)
 		require.Equal(t, tExists, tt.expectedExists)
 	}
 }
@@ -257,8 +199,8 @@ func Test_ListTestSuiteNames(t *testing.T) {
 		},
 	}
 	for _, tt := range testData {
-		mCli := octopus.NewMockedOctopusRestClient(nil, &tt.inputTestSuites, nil)
-		dNames, err := listTestSuiteNames(mCli)
+		mCli := fake.NewSimpleClient(&tt.inputTestSuites)
+		dNames, err := listTestSuiteNames(context.Background(), mCli)
 		if !tt.shouldFail {
 			require.Nil(t, err, tt.testName)
 			require.Equal(t, dNames, tt.expectedResult)
@@ -320,30 +262,26 @@ func Test_WaitForTestSuite(t *testing.T) {
 				},
 			}
 
-			inputTestSuites := oct.ClusterTestSuiteList{
-				Items: []oct.ClusterTestSuite{
-					*fixTestSuite.DeepCopy(),
-				},
-			}
-
-			fakeWatcher := watch.NewFake()
 			mStep := &mocks.Step{}
-			mCli := octopus.NewMockedOctopusRestClient(nil, &inputTestSuites, fakeWatcher)
+			mCli := fake.NewSimpleClient(fixTestSuite.DeepCopy())
 
 			// when
 			waitForTestSuiteDone := make(chan struct{}, 1)
 			var waitErr error
 			go func() {
-				waitErr = waitForTestSuite(mCli, fixTestSuite.Name, clusterTestSuiteCompleted(mStep), 5*time.Second)
+				waitErr = test.WaitForTestSuite(context.Background(), mCli, fixTestSuite.Name, clusterTestSuiteCompleted(mStep), 5*time.Second)
 				waitForTestSuiteDone <- struct{}{}
 			}()
 
-			// simulates the octopus controller - emit events with modified statuses
+			// updates are only seen by the wait once it watches the test suite
+			require.Eventually(t, func() bool { return mCli.Watches() > 0 }, time.Second, time.Millisecond)
+
+			// simulates the octopus controller - update the test suite with modified statuses
 			for _, newStatus := range tc.statusesSequence {
 				modified := fixTestSuite.DeepCopy()
 				modified.Status = newStatus
-				fakeWatcher.Modify(modified)
-
+				_, err := mCli.UpdateTestSuite(context.Background(), modified)
+				require.NoError(t, err)
 			}
 
 			// then
@@ -359,6 +297,96 @@ func Test_WaitForTestSuite(t *testing.T) {
 	}
 }
 
+func Test_suiteExitError(t *testing.T) {
+	testData := []struct {
+		testName string
+		status   oct.TestSuiteStatus
+		exitCode int
+	}{
+		{testName: "suite succeeded", status: statusSuiteSucceeded(), exitCode: 0},
+		{testName: "suite failed", status: statusSuiteFailed(), exitCode: cli.ExitCodeFailure},
+		{testName: "suite errored", status: statusSuiteError(), exitCode: cli.ExitCodeError},
+		{testName: "suite not finished", status: statusRunning(), exitCode: cli.ExitCodeError},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := suiteExitError(&oct.ClusterTestSuite{ObjectMeta: metav1.ObjectMeta{Name: "suite"}, Status: tt.status})
+			if tt.exitCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tt.exitCode, cli.ExitCode(err))
+		})
+	}
+}
+
+func Test_createTestSuiteExitError(t *testing.T) {
+	testData := []struct {
+		testName  string
+		wait      bool
+		listErr   error
+		createErr error
+		exitCode  int
+	}{
+		{testName: "suite created", wait: true},
+		{testName: "listing test definitions fails", wait: true, listErr: errors.New("connection refused"), exitCode: cli.ExitCodeError},
+		{testName: "creating test suite fails", wait: true, createErr: errors.New("connection refused"), exitCode: cli.ExitCodeError},
+		{testName: "creating test suite fails without waiting", createErr: errors.New("connection refused"), exitCode: cli.ExitCodeFailure},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.testName, func(t *testing.T) {
+			mCli := &failingClient{
+				Interface: fake.NewSimpleClient(&oct.TestDefinition{ObjectMeta: metav1.ObjectMeta{Name: "test1", Namespace: "default"}}),
+				listErr:   tt.listErr,
+				createErr: tt.createErr,
+			}
+			filter, err := newTestFilter(nil, nil, "", "")
+			require.NoError(t, err)
+
+			cmd := command{opts: &Options{Wait: tt.wait}}
+			_, err = cmd.createTestSuite(context.Background(), mCli, "suite", filter, nil)
+			if tt.exitCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(t, tt.exitCode, cli.ExitCode(err))
+		})
+	}
+}
+
+func Test_validateFlags(t *testing.T) {
+	testData := []struct {
+		testName   string
+		opts       Options
+		shouldFail bool
+	}{
+		{testName: "no flags", opts: Options{}},
+		{testName: "CI step", opts: Options{Wait: true, Report: "junit", ReportFile: "report.xml", LogsOnFailure: true, DeleteAfter: true}},
+		{testName: "archive when watching", opts: Options{Watch: true, Archive: true}},
+		{testName: "archive without waiting", opts: Options{Archive: true}, shouldFail: true},
+		{testName: "report without waiting", opts: Options{Watch: true, Report: "html"}, shouldFail: true},
+		{testName: "report file without report", opts: Options{Wait: true, ReportFile: "report.xml"}, shouldFail: true},
+		{testName: "unknown report format", opts: Options{Wait: true, Report: "pdf"}, shouldFail: true},
+		{testName: "delete without waiting", opts: Options{DeleteAfter: true}, shouldFail: true},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.testName, func(t *testing.T) {
+			cmd := command{opts: &tt.opts}
+			err := cmd.validateFlags()
+			if tt.shouldFail {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func waitForChanAtMost(t *testing.T, ch <-chan struct{}, timeout time.Duration) {
 	select {
 	case <-ch:
@@ -408,3 +436,24 @@ func testSuiteStatus(testStatus oct.TestStatus, suiteStatus oct.TestSuiteConditi
 		},
 	}
 }
+
+// failingClient fails listing test definitions or creating test suites with the given errors
+type failingClient struct {
+	octopus.Interface
+	listErr   error
+	createErr error
+}
+
+func (c *failingClient) ListTestDefinitions(ctx context.Context, opts metav1.ListOptions) (*oct.TestDefinitionList, error) {
+	if c.listErr != nil {
+		return nil, c.listErr
+	}
+	return c.Interface.ListTestDefinitions(ctx, opts)
+}
+
+func (c *failingClient) CreateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (*oct.ClusterTestSuite, error) {
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+	return c.Interface.CreateTestSuite(ctx, cts)
+}
@@ -0,0 +1,87 @@
+package run
+
+import (
+	"fmt"
+	"regexp"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// componentLabel is the label of test definitions which holds the name of the Kyma component they test.
+const componentLabel = "kyma-project.io/component"
+
+// testFilter selects test definitions by their labels and names.
+type testFilter struct {
+	// expressions are label selectors of which a test definition must match at least one, just like in a ClusterTestSuite
+	expressions []string
+	selectors   []labels.Selector
+	match       *regexp.Regexp
+	exclude     *regexp.Regexp
+}
+
+// newTestFilter creates a filter for the given label selectors, components and name patterns, all of which are optional.
+func newTestFilter(selectors, components []string, match, exclude string) (*testFilter, error) {
+	f := &testFilter{}
+
+	for _, c := range components {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", componentLabel, c))
+	}
+	for _, s := range selectors {
+		sel, err := labels.Parse(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid label selector '%s'", s)
+		}
+		f.expressions = append(f.expressions, s)
+		f.selectors = append(f.selectors, sel)
+	}
+
+	var err error
+	if match != "" {
+		if f.match, err = regexp.Compile(match); err != nil {
+			return nil, errors.Wrapf(err, "Invalid regular expression '%s' for matching test definitions", match)
+		}
+	}
+	if exclude != "" {
+		if f.exclude, err = regexp.Compile(exclude); err != nil {
+			return nil, errors.Wrapf(err, "Invalid regular expression '%s' for excluding test definitions", exclude)
+		}
+	}
+	return f, nil
+}
+
+// labelsOnly tells if the filter only selects by labels, which the ClusterTestSuite can do on its own.
+func (f *testFilter) labelsOnly() bool {
+	return len(f.selectors) > 0 && f.match == nil && f.exclude == nil
+}
+
+// matches tells if the test definition passes the filter.
+func (f *testFilter) matches(td oct.TestDefinition) bool {
+	if f.match != nil && !f.match.MatchString(td.GetName()) {
+		return false
+	}
+	if f.exclude != nil && f.exclude.MatchString(td.GetName()) {
+		return false
+	}
+	if len(f.selectors) == 0 {
+		return true
+	}
+	for _, sel := range f.selectors {
+		if sel.Matches(labels.Set(td.GetLabels())) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply returns the test definitions which pass the filter.
+func (f *testFilter) apply(testDefs []oct.TestDefinition) []oct.TestDefinition {
+	result := []oct.TestDefinition{}
+	for _, td := range testDefs {
+		if f.matches(td) {
+			result = append(result, td)
+		}
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package run
+
+import (
+	"testing"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_testFilter(t *testing.T) {
+	testDefs := []oct.TestDefinition{
+		fixTestDefinition("core-api-gateway", map[string]string{componentLabel: "api-gateway", "team": "huskies"}),
+		fixTestDefinition("core-upgrade", map[string]string{componentLabel: "api-gateway", "team": "tunas"}),
+		fixTestDefinition("serverless", map[string]string{componentLabel: "serverless", "team": "tunas"}),
+		fixTestDefinition("console-backend", nil),
+	}
+
+	testData := []struct {
+		testName   string
+		selectors  []string
+		components []string
+		match      string
+		exclude    string
+		shouldFail bool
+		labelsOnly bool
+		result     []string
+	}{
+		{
+			testName: "no filter",
+			result:   []string{"core-api-gateway", "core-upgrade", "serverless", "console-backend"},
+		},
+		{
+			testName:   "selectors match at least one",
+			selectors:  []string{"team=huskies", "app in (serverless)", "component"},
+			labelsOnly: true,
+			result:     []string{"core-api-gateway"},
+		},
+		{
+			testName:   "components",
+			components: []string{"serverless", "api-gateway"},
+			labelsOnly: true,
+			result:     []string{"core-api-gateway", "core-upgrade", "serverless"},
+		},
+		{
+			testName: "match and exclude",
+			match:    "^core-",
+			exclude:  "upgrade",
+			result:   []string{"core-api-gateway"},
+		},
+		{
+			testName:  "selectors combined with exclude",
+			selectors: []string{"team=tunas"},
+			exclude:   "^core-",
+			result:    []string{"serverless"},
+		},
+		{
+			testName:   "invalid selector",
+			selectors:  []string{"team in huskies"},
+			shouldFail: true,
+		},
+		{
+			testName:   "invalid regex",
+			match:      "core-(",
+			shouldFail: true,
+		},
+	}
+
+	for _, tt := range testData {
+		f, err := newTestFilter(tt.selectors, tt.components, tt.match, tt.exclude)
+		if tt.shouldFail {
+			require.Error(t, err, tt.testName)
+			continue
+		}
+		require.NoError(t, err, tt.testName)
+		require.Equal(t, tt.labelsOnly, f.labelsOnly(), tt.testName)
+
+		var names []string
+		for _, td := range f.apply(testDefs) {
+			names = append(names, td.GetName())
+		}
+		require.Equal(t, tt.result, names, tt.testName)
+	}
+}
+
+func Test_testFilterExpressions(t *testing.T) {
+	f, err := newTestFilter([]string{"team=huskies"}, []string{"serverless"}, "", "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"team=huskies", componentLabel + "=serverless"}, f.expressions, "Components must be turned into label expressions.")
+}
+
+func fixTestDefinition(name string, labels map[string]string) oct.TestDefinition {
+	return oct.TestDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "kyma-system",
+			Labels:    labels,
+		},
+	}
+}
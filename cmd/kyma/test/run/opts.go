@@ -10,10 +10,20 @@ type Options struct {
 	*cli.Options
 	Name           string
 	Watch          bool
+	Wait           bool
+	Archive        bool
+	Report         string
+	ReportFile     string
+	LogsOnFailure  bool
+	DeleteAfter    bool
 	Timeout        time.Duration
 	ExecutionCount int64
 	MaxRetries     int64
 	Concurrency    int64
+	Selectors      []string
+	Components     []string
+	Match          string
+	Exclude        string
 }
 
 func NewOptions(o *cli.Options) *Options {
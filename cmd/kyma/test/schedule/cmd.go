@@ -0,0 +1,17 @@
+package schedule
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Manages test suites which run periodically on a Kyma cluster.",
+		Long: `Use this command to run tests periodically on a Kyma cluster, for example for continuous conformance testing of long-lived environments.
+
+Every schedule is a CronJob in the "` + Namespace + `" Namespace which creates a new test suite through the Octopus API at the scheduled time.
+`,
+	}
+	return cmd
+}
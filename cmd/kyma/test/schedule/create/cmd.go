@@ -0,0 +1,102 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/cmd/kyma/test"
+	"github.com/kyma-project/cli/cmd/kyma/test/schedule"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+func NewCmd(o *Options) *cobra.Command {
+	cmd := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "create <test-definition-1> <test-definition-2> ... <test-definition-N>",
+		Short: "Creates a schedule which runs tests periodically.",
+		Long: `Use this command to create a schedule which runs the given test definitions periodically.
+
+If you don't provide any specific test definitions, every run includes all test definitions available at that time.
+To run all tests every 30 minutes, run ` + "`kyma test schedule create -n health --cron \"*/30 * * * *\"`" + `.
+The test suites created by the schedule are named after the schedule. Run ` + "`kyma test schedule history <schedule>`" + ` to see their results.
+`,
+		RunE: func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
+	}
+
+	cobraCmd.Flags().StringVarP(&o.Name, "name", "n", "", "Name of the schedule.")
+	cobraCmd.Flags().StringVar(&o.Cron, "cron", "", `Schedule in the cron format, such as "*/30 * * * *", or a predefined schedule, such as "@hourly".`)
+	cobraCmd.Flags().StringVar(&o.Image, "image", schedule.DefaultImage, "Container image with kubectl which creates the test suites.")
+	cobraCmd.Flags().Int64VarP(&o.ExecutionCount, "count", "c", 1, `Number of times every test should be executed. "count" and "max-retries" flags are mutually exclusive.`)
+	cobraCmd.Flags().Int64VarP(&o.MaxRetries, "max-retries", "", 0, `Number of times a given test is retried when it fails.`)
+	cobraCmd.Flags().Int64VarP(&o.Concurrency, "concurrency", "", 5, "Number of tests to be executed in parallel.")
+	return cobraCmd
+}
+
+func (cmd *command) Run(args []string) error {
+	if cmd.opts.Name == "" {
+		return fmt.Errorf(`The name of the schedule is required, use the "--name" flag`)
+	}
+	if err := schedule.ValidateCron(cmd.opts.Cron); err != nil {
+		return err
+	}
+
+	var err error
+	if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
+	}
+
+	suite := test.NewTestSuite(cmd.opts.Name)
+	suite.Spec.Count = cmd.opts.ExecutionCount
+	suite.Spec.MaxRetries = cmd.opts.MaxRetries
+	suite.Spec.Concurrency = cmd.opts.Concurrency
+	if len(args) > 0 {
+		if suite.Spec.Selectors.MatchNames, err = resolveTestDefinitions(cmd.Context(), cmd.K8s.Octopus(), args); err != nil {
+			return err
+		}
+	}
+
+	err = schedule.Create(cmd.K8s.Static(), schedule.Schedule{
+		Name:  cmd.opts.Name,
+		Cron:  cmd.opts.Cron,
+		Image: cmd.opts.Image,
+		Suite: suite,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Schedule '%s' successfully created\n", cmd.opts.Name)
+	return nil
+}
+
+// resolveTestDefinitions finds the Namespaces of the given test definitions
+func resolveTestDefinitions(ctx context.Context, cli octopus.Interface, names []string) ([]oct.TestDefReference, error) {
+	defs, err := cli.ListTestDefinitions(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to get the list of test definitions")
+	}
+
+	matched, err := test.MatchTestDefinitions(names, defs.Items)
+	if err != nil {
+		return nil, err
+	}
+	var refs []oct.TestDefReference
+	for _, td := range matched {
+		refs = append(refs, oct.TestDefReference{Name: td.GetName(), Namespace: td.GetNamespace()})
+	}
+	return refs, nil
+}
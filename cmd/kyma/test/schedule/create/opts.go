@@ -0,0 +1,17 @@
+package create
+
+import "github.com/kyma-project/cli/internal/cli"
+
+type Options struct {
+	*cli.Options
+	Name           string
+	Cron           string
+	Image          string
+	ExecutionCount int64
+	MaxRetries     int64
+	Concurrency    int64
+}
+
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
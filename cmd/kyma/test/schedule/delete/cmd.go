@@ -0,0 +1,53 @@
+package del
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli/cmd/kyma/test/schedule"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+func NewCmd(o *Options) *cobra.Command {
+	cmd := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "delete <schedule-1> <schedule-2> ... <schedule-N>",
+		Short: "Deletes test schedules.",
+		Long: `Use this command to delete test schedules. The test suites which were already created by the schedules are kept.
+
+Provide at least one schedule name.`,
+		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
+		Aliases: []string{"d"},
+	}
+	return cobraCmd
+}
+
+func (cmd *command) Run(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("Schedule name required")
+	}
+
+	var err error
+	if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
+	}
+
+	for _, name := range args {
+		if err := schedule.Delete(cmd.K8s.Static(), name); err != nil {
+			return err
+		}
+		fmt.Printf("Schedule '%s' successfully deleted\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package history
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/cmd/kyma/test/schedule"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+func NewCmd(o *Options) *cobra.Command {
+	cmd := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:   "history <schedule>",
+		Short: "Shows the test suites created by a test schedule.",
+		Long: `Use this command to display the results of the test suites created by a test schedule, the newest first.
+
+To see the details of a single run, run ` + "`kyma test status <test-suite>`" + `.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error { return cmd.Run(args[0]) },
+	}
+	return cobraCmd
+}
+
+func (cmd *command) Run(name string) error {
+	var err error
+	if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
+	}
+
+	suites, err := cmd.K8s.Octopus().ListTestSuites(cmd.Context(), metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", schedule.LabelKey, name)})
+	if err != nil {
+		return errors.Wrapf(err, "unable to list the test suites of schedule '%s'", name)
+	}
+	if len(suites.Items) == 0 {
+		fmt.Printf("No test suites found for schedule '%s'\n", name)
+		return nil
+	}
+
+	printHistory(os.Stdout, suites.Items)
+	return nil
+}
+
+func printHistory(out io.Writer, suites []oct.ClusterTestSuite) {
+	sort.Slice(suites, func(i, j int) bool {
+		return suites[j].CreationTimestamp.Before(&suites[i].CreationTimestamp)
+	})
+
+	writer := cli.NewTableWriter([]string{"TEST SUITE", "CREATED", "CONDITION", "SUCCEEDED", "FAILED"}, out)
+	for _, s := range suites {
+		condition := "Unknown"
+		for _, c := range s.Status.Conditions {
+			if c.Status == oct.StatusTrue {
+				condition = string(c.Type)
+			}
+		}
+
+		var succeeded, failed int
+		for _, r := range s.Status.Results {
+			switch r.Status {
+			case oct.TestSucceeded:
+				succeeded++
+			case oct.TestFailed:
+				failed++
+			}
+		}
+		writer.Append([]string{s.Name, s.CreationTimestamp.UTC().Format(time.RFC3339), condition, fmt.Sprint(succeeded), fmt.Sprint(failed)})
+	}
+	writer.Render()
+}
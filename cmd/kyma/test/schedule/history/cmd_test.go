@@ -0,0 +1,40 @@
+package history
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_printHistory(t *testing.T) {
+	created := time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC)
+	suites := []oct.ClusterTestSuite{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "health-older", CreationTimestamp: metav1.Time{Time: created}},
+			Status: oct.TestSuiteStatus{
+				Conditions: []oct.TestSuiteCondition{{Type: oct.SuiteFailed, Status: oct.StatusTrue}},
+				Results:    []oct.TestResult{{Status: oct.TestSucceeded}, {Status: oct.TestFailed}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "health-newer", CreationTimestamp: metav1.Time{Time: created.Add(30 * time.Minute)}},
+			Status: oct.TestSuiteStatus{
+				Conditions: []oct.TestSuiteCondition{{Type: oct.SuiteRunning, Status: oct.StatusTrue}},
+			},
+		},
+	}
+
+	out := &bytes.Buffer{}
+	printHistory(out, suites)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Contains(t, lines[1], "health-newer", "The newest test suite must be printed first.")
+	require.Contains(t, lines[1], "Running")
+	require.Contains(t, lines[2], "Failed")
+}
@@ -0,0 +1,71 @@
+package list
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kyma-project/cli/cmd/kyma/test/schedule"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+func NewCmd(o *Options) *cobra.Command {
+	cmd := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cobraCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the test schedules of a Kyma cluster.",
+		Long:    `Use this command to list the test schedules of a Kyma cluster, including their cron schedule and when they last created a test suite.`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+		Aliases: []string{"l"},
+	}
+	return cobraCmd
+}
+
+func (cmd *command) Run() error {
+	var err error
+	if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid.")
+	}
+
+	jobs, err := schedule.List(cmd.K8s.Static())
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		fmt.Println("No test schedules found")
+		return nil
+	}
+
+	printSchedules(os.Stdout, jobs)
+	return nil
+}
+
+func printSchedules(out io.Writer, jobs []batchv1beta1.CronJob) {
+	writer := cli.NewTableWriter([]string{"NAME", "SCHEDULE", "TESTS", "SUSPENDED", "LAST RUN"}, out)
+	for _, j := range jobs {
+		suspended := "No"
+		if j.Spec.Suspend != nil && *j.Spec.Suspend {
+			suspended = "Yes"
+		}
+		lastRun := "Never"
+		if j.Status.LastScheduleTime != nil {
+			lastRun = j.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+		}
+		writer.Append([]string{j.Name, j.Spec.Schedule, j.Annotations[schedule.TestsAnnotation], suspended, lastRun})
+	}
+	writer.Render()
+}
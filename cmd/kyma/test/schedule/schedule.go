@@ -0,0 +1,277 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/pkg/errors"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// Namespace is where the CronJobs of the test schedules live
+	Namespace = "kyma-system"
+	// LabelKey marks the CronJobs of the test schedules and the test suites created by them
+	LabelKey = "testing.kyma-project.io/schedule"
+	// TestsAnnotation lists the test definitions run by a schedule
+	TestsAnnotation = "testing.kyma-project.io/tests"
+	// DefaultImage provides kubectl, which creates the test suites
+	DefaultImage = "bitnami/kubectl:1.15"
+
+	schedulerName = "kyma-test-scheduler"
+	suiteFile     = "suite.json"
+	suiteDir      = "/etc/test-suite"
+)
+
+// Schedule describes a test suite which is created periodically
+type Schedule struct {
+	Name  string
+	Cron  string
+	Image string
+	Suite *oct.ClusterTestSuite
+}
+
+// Create creates the CronJob of the schedule and everything it needs to create test suites
+func Create(k8s kubernetes.Interface, s Schedule) error {
+	if err := ValidateCron(s.Cron); err != nil {
+		return err
+	}
+	if err := ensureScheduler(k8s); err != nil {
+		return err
+	}
+
+	// every run gets a new test suite, named after the schedule
+	suite := s.Suite.DeepCopy()
+	suite.Name = ""
+	suite.GenerateName = s.Name + "-"
+	suite.Labels = map[string]string{LabelKey: s.Name}
+	manifest, err := json.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "unable to marshal the test suite of schedule '%s'", s.Name)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: Namespace, Labels: map[string]string{LabelKey: s.Name}},
+		Data:       map[string]string{suiteFile: string(manifest)},
+	}
+	if _, err := k8s.CoreV1().ConfigMaps(Namespace).Create(cm); err != nil {
+		return errors.Wrapf(err, "unable to create the test suite template of schedule '%s'", s.Name)
+	}
+
+	if _, err := k8s.BatchV1beta1().CronJobs(Namespace).Create(newCronJob(s)); err != nil {
+		// without the CronJob, the template would be left behind and block creating the schedule again
+		if cleanupErr := k8s.CoreV1().ConfigMaps(Namespace).Delete(s.Name, &metav1.DeleteOptions{}); cleanupErr != nil && !apierrors.IsNotFound(cleanupErr) {
+			return errors.Wrapf(err, "unable to create the CronJob of schedule '%s', and the test suite template '%s/%s' could not be removed: %s", s.Name, Namespace, s.Name, cleanupErr)
+		}
+		return errors.Wrapf(err, "unable to create the CronJob of schedule '%s'", s.Name)
+	}
+	return nil
+}
+
+// List returns the CronJobs of all test schedules
+func List(k8s kubernetes.Interface) ([]batchv1beta1.CronJob, error) {
+	jobs, err := k8s.BatchV1beta1().CronJobs(Namespace).List(metav1.ListOptions{LabelSelector: LabelKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list the test schedules")
+	}
+	return jobs.Items, nil
+}
+
+// Delete deletes the CronJob of the schedule, its jobs and the test suite template.
+// Test suites which were already created by the schedule are kept.
+func Delete(k8s kubernetes.Interface, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	if err := k8s.BatchV1beta1().CronJobs(Namespace).Delete(name, &metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+		return errors.Wrapf(err, "unable to delete schedule '%s'", name)
+	}
+	if err := k8s.CoreV1().ConfigMaps(Namespace).Delete(name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "unable to delete the test suite template of schedule '%s'", name)
+	}
+	return nil
+}
+
+// ValidateCron checks that the schedule is either a cron expression with five fields or a predefined schedule such as "@hourly"
+func ValidateCron(cron string) error {
+	cron = strings.TrimSpace(cron)
+	if strings.HasPrefix(cron, "@") {
+		switch cron {
+		case "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly":
+			return nil
+		}
+		return fmt.Errorf("invalid schedule %q: unknown predefined schedule", cron)
+	}
+	fields := strings.Fields(cron)
+	if len(fields) != 5 {
+		return fmt.Errorf("invalid schedule %q: a cron expression needs 5 fields, got %d", cron, len(fields))
+	}
+	for i, f := range fields {
+		if err := cronFields[i].validate(f); err != nil {
+			return fmt.Errorf("invalid schedule %q: %s field: %s", cron, cronFields[i].name, err)
+		}
+	}
+	return nil
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+	anyMark  bool
+}
+
+// cronFields follow the cron format of the CronJob controller, which allows "?" in the day fields
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31, anyMark: true},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}, anyMark: true},
+}
+
+// validate checks a comma-separated list of "*", single values or ranges, each with an optional step such as "*/15" or "1-5/2"
+func (f cronField) validate(field string) error {
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, ""
+		if i := strings.Index(part, "/"); i >= 0 {
+			rng, step = part[:i], part[i+1:]
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Errorf("invalid step '%s'", step)
+			}
+		}
+		if rng == "*" || (f.anyMark && rng == "?") {
+			continue
+		}
+		bounds := strings.SplitN(rng, "-", 2)
+		low, err := f.value(bounds[0])
+		if err != nil {
+			return err
+		}
+		if len(bounds) == 2 {
+			high, err := f.value(bounds[1])
+			if err != nil {
+				return err
+			}
+			if low > high {
+				return fmt.Errorf("invalid range '%s'", rng)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("'%s' is not a value between %d and %d", s, f.min, f.max)
+	}
+	return n, nil
+}
+
+func newCronJob(s Schedule) *batchv1beta1.CronJob {
+	tests := "all"
+	if names := s.Suite.Spec.Selectors.MatchNames; len(names) > 0 {
+		var n []string
+		for _, td := range names {
+			n = append(n, td.Name)
+		}
+		tests = strings.Join(n, ",")
+	}
+	historyLimit := int32(3)
+
+	return &batchv1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        s.Name,
+			Namespace:   Namespace,
+			Labels:      map[string]string{LabelKey: s.Name},
+			Annotations: map[string]string{TestsAnnotation: tests},
+		},
+		Spec: batchv1beta1.CronJobSpec{
+			Schedule:                   s.Cron,
+			ConcurrencyPolicy:          batchv1beta1.ForbidConcurrent,
+			SuccessfulJobsHistoryLimit: &historyLimit,
+			FailedJobsHistoryLimit:     &historyLimit,
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{LabelKey: s.Name},
+							// the job must be able to complete, which an injected sidecar would prevent
+							Annotations: map[string]string{"sidecar.istio.io/inject": "false"},
+						},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: schedulerName,
+							RestartPolicy:      corev1.RestartPolicyOnFailure,
+							Containers: []corev1.Container{
+								{
+									Name:    "create-test-suite",
+									Image:   s.Image,
+									Command: []string{"kubectl"},
+									Args:    []string{"create", "-f", suiteDir + "/" + suiteFile},
+									VolumeMounts: []corev1.VolumeMount{
+										{Name: "test-suite", MountPath: suiteDir, ReadOnly: true},
+									},
+								},
+							},
+							Volumes: []corev1.Volume{
+								{
+									Name: "test-suite",
+									VolumeSource: corev1.VolumeSource{
+										ConfigMap: &corev1.ConfigMapVolumeSource{
+											LocalObjectReference: corev1.LocalObjectReference{Name: s.Name},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ensureScheduler creates the service account which is allowed to create test suites, if it does not exist yet
+func ensureScheduler(k8s kubernetes.Interface) error {
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: schedulerName, Namespace: Namespace}}
+	if _, err := k8s.CoreV1().ServiceAccounts(Namespace).Create(sa); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrap(err, "unable to create the service account of the test scheduler")
+	}
+
+	role := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: schedulerName},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{oct.SchemeGroupVersion.Group},
+				Resources: []string{"clustertestsuites"},
+				Verbs:     []string{"create"},
+			},
+		},
+	}
+	if _, err := k8s.RbacV1().ClusterRoles().Create(role); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrap(err, "unable to create the cluster role of the test scheduler")
+	}
+
+	binding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: schedulerName},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: schedulerName},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: schedulerName, Namespace: Namespace}},
+	}
+	if _, err := k8s.RbacV1().ClusterRoleBindings().Create(binding); err != nil && !apierrors.IsAlreadyExists(err) {
+		return errors.Wrap(err, "unable to create the cluster role binding of the test scheduler")
+	}
+	return nil
+}
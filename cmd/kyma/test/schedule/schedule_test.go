@@ -0,0 +1,82 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCreateAndDelete(t *testing.T) {
+	// setup
+	k8s := fake.NewSimpleClientset()
+	suite := &oct.ClusterTestSuite{ObjectMeta: metav1.ObjectMeta{Name: "health"}}
+	suite.Spec.Selectors.MatchNames = []oct.TestDefReference{{Name: "core", Namespace: "kyma-system"}, {Name: "logging", Namespace: "kyma-system"}}
+
+	// create two schedules, the second one must reuse the scheduler service account
+	require.NoError(t, Create(k8s, Schedule{Name: "health", Cron: "*/30 * * * *", Image: DefaultImage, Suite: suite}))
+	require.NoError(t, Create(k8s, Schedule{Name: "nightly", Cron: "@daily", Image: DefaultImage, Suite: &oct.ClusterTestSuite{}}))
+
+	_, err := k8s.RbacV1().ClusterRoleBindings().Get(schedulerName, metav1.GetOptions{})
+	require.NoError(t, err, "The scheduler must be allowed to create test suites.")
+
+	cm, err := k8s.CoreV1().ConfigMaps(Namespace).Get("health", metav1.GetOptions{})
+	require.NoError(t, err)
+	created := &oct.ClusterTestSuite{}
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[suiteFile]), created))
+	require.Empty(t, created.Name)
+	require.Equal(t, "health-", created.GenerateName, "Every run must create a new test suite.")
+	require.Equal(t, "health", created.Labels[LabelKey])
+	require.Len(t, created.Spec.Selectors.MatchNames, 2)
+
+	jobs, err := List(k8s)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, "*/30 * * * *", jobs[0].Spec.Schedule)
+	require.Equal(t, "core,logging", jobs[0].Annotations[TestsAnnotation])
+	require.Equal(t, "all", jobs[1].Annotations[TestsAnnotation])
+	require.Equal(t, schedulerName, jobs[0].Spec.JobTemplate.Spec.Template.Spec.ServiceAccountName)
+
+	// delete
+	require.NoError(t, Delete(k8s, "health"))
+	jobs, err = List(k8s)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	_, err = k8s.CoreV1().ConfigMaps(Namespace).Get("health", metav1.GetOptions{})
+	require.Error(t, err, "The test suite template must be deleted with the schedule.")
+	require.Error(t, Delete(k8s, "health"), "Deleting a non existing schedule must fail.")
+}
+
+func TestCreateCleansUpOnFailure(t *testing.T) {
+	k8s := fake.NewSimpleClientset()
+	k8s.PrependReactor("create", "cronjobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("quota exceeded")
+	})
+
+	err := Create(k8s, Schedule{Name: "health", Cron: "@daily", Image: DefaultImage, Suite: &oct.ClusterTestSuite{}})
+	require.Error(t, err)
+	_, err = k8s.CoreV1().ConfigMaps(Namespace).Get("health", metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err), "The test suite template must not be left behind without a CronJob.")
+}
+
+func TestValidateCron(t *testing.T) {
+	require.NoError(t, ValidateCron("*/30 * * * *"))
+	require.NoError(t, ValidateCron("0 3 * * 1-5"))
+	require.NoError(t, ValidateCron("@hourly"))
+	require.NoError(t, ValidateCron("0,30 8-18/2 ? jan-jun MON-FRI"))
+	require.Error(t, ValidateCron(""))
+	require.Error(t, ValidateCron("*/30 * * *"))
+	require.Error(t, ValidateCron("@sometimes"))
+	require.Error(t, ValidateCron("* * * * foo"))
+	require.Error(t, ValidateCron("60 * * * *"))
+	require.Error(t, ValidateCron("*/0 * * * *"))
+	require.Error(t, ValidateCron("0 0 0 * *"))
+	require.Error(t, ValidateCron("0 18-8 * * *"))
+}
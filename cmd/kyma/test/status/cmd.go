@@ -9,9 +9,7 @@ import (
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
 	"github.com/kyma-project/cli/cmd/kyma/test"
 	"github.com/kyma-project/cli/internal/cli"
-	"github.com/kyma-project/cli/internal/junitxml"
 	"github.com/kyma-project/cli/internal/kube"
-	"github.com/kyma-project/cli/internal/logs"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
@@ -37,6 +35,7 @@ func NewCmd(o *Options) *cobra.Command {
 If you don't provide any arguments, the status of all test suites will be printed.
 To print the status of all test suites, run ` + "`kyma test status`" + `.
 To print the status of specific test cases, run ` + "`kyma test status testSuiteOne testSuiteTwo`" + `.
+To create a self-contained HTML report with a timeline and the logs of all tests, run ` + "`kyma test status testSuiteOne -o html --report-file report.html`" + `.
 `,
 
 		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
@@ -44,11 +43,24 @@ To print the status of specific test cases, run ` + "`kyma test status testSuite
 	}
 
 	cobraCmd.Flags().StringVarP(&o.OutputFormat, "output", "o", "",
-		"Output format. One of: json|yaml|wide|junit")
+		"Output format. One of: json|yaml|wide|junit|html")
+	cobraCmd.Flags().StringVar(&o.ReportFile, "report-file", "",
+		`File to which the "junit" or "html" report is written. By default, the report is printed to the standard output.`)
 	return cobraCmd
 }
 
 func (cmd *command) Run(args []string) error {
+	if cmd.opts.ReportFile != "" {
+		switch strings.ToLower(cmd.opts.OutputFormat) {
+		case "junit", "html":
+		default:
+			return fmt.Errorf(`The "--report-file" flag requires the "junit" or "html" output format`)
+		}
+		if len(args) != 1 {
+			return fmt.Errorf(`The "--report-file" flag requires exactly one test suite name`)
+		}
+	}
+
 	var err error
 	if cmd.K8s, err = kube.NewFromConfig("", cmd.KubeconfigPath); err != nil {
 		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure that your kubeconfig is valid.")
@@ -56,14 +68,14 @@ func (cmd *command) Run(args []string) error {
 
 	switch len(args) {
 	case 1:
-		testSuite, err := cmd.K8s.Octopus().GetTestSuite(args[0], metav1.GetOptions{})
+		testSuite, err := cmd.K8s.Octopus().GetTestSuite(cmd.Context(), args[0], metav1.GetOptions{})
 		if err != nil {
 			return errors.Wrap(err, fmt.Sprintf("unable to get test suite '%s'",
 				args[0]))
 		}
 		return cmd.printTestSuiteStatus(testSuite, cmd.opts.OutputFormat)
 	case 0:
-		testList, err := cmd.K8s.Octopus().ListTestSuites(metav1.ListOptions{})
+		testList, err := cmd.K8s.Octopus().ListTestSuites(cmd.Context(), metav1.ListOptions{})
 		if err != nil {
 			return errors.Wrap(err, "unable to list test suites")
 		}
@@ -79,7 +91,7 @@ func (cmd *command) Run(args []string) error {
 			}
 		}
 	default:
-		testsList, err := test.ListTestSuitesByName(cmd.K8s.Octopus(), args)
+		testsList, err := test.ListTestSuitesByName(cmd.Context(), cmd.K8s.Octopus(), args)
 		if err != nil {
 			return errors.Wrap(err, "unable to list test suites")
 		}
@@ -113,12 +125,8 @@ func (cmd *command) printTestSuiteStatus(testSuite *oct.ClusterTestSuite, output
 		return nil
 	case "wide":
 		printTestSuite(testSuite, true)
-	case "junit":
-		logsFetcher := logs.NewFetcherForTestingPods(cmd.K8s.Static().CoreV1(), []string{})
-		junitCreator := junitxml.NewCreator(logsFetcher)
-		if err := junitCreator.Write(os.Stdout, testSuite); err != nil {
-			return errors.Wrapf(err, "while writing junit report for '%s' test suite", testSuite.GetName())
-		}
+	case "junit", "html":
+		return test.WriteReport(cmd.opts.ReportFile, outputFormat, testSuite, cmd.K8s.Static().CoreV1())
 	default:
 		printTestSuite(testSuite, false)
 	}
@@ -143,7 +151,7 @@ func printTestSuite(testSuite *oct.ClusterTestSuite, wide bool) {
 
 	fmt.Printf("Condition:\t%s\r\n", testSuite.Status.Conditions[len(testSuite.Status.Conditions)-1].Type)
 
-	writer := test.NewTableWriter([]string{}, os.Stdout)
+	writer := cli.NewTableWriter([]string{}, os.Stdout)
 	for _, t := range testSuite.Status.Results {
 
 		if wide {
@@ -8,6 +8,7 @@ type Options struct {
 	*cli.Options
 	Wait         bool
 	OutputFormat string
+	ReportFile   string
 }
 
 func NewOptions(o *cli.Options) *Options {
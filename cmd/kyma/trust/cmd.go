@@ -0,0 +1,21 @@
+package trust
+
+import (
+	"github.com/spf13/cobra"
+)
+
+//NewCmd creates a new trust command
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trust",
+		Short: "Manages the Kyma root certificates trusted on this machine.",
+		Long: `Use this command to manage the root certificates of Kyma clusters which are trusted on this machine.
+
+The certificates can be kept in the following stores:
+- ` + "`system`" + `: The trusted root certificates of the OS. Changing them requires root privileges.
+- ` + "`nss`" + `: The NSS databases used by browsers, such as Firefox and Chrome. Requires the NSS certutil tool.
+- ` + "`user`" + `: A certificate bundle in the Kyma CLI home folder, which does not require root privileges.
+`,
+	}
+	return cmd
+}
@@ -0,0 +1,71 @@
+package export
+
+import (
+	"io/ioutil"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const defaultOutput = "kyma.crt"
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new trust export command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the Kyma root certificate of the current cluster to a file.",
+		Long: `Use this command to save the Kyma root certificate of the current cluster, as stored in the ` + "`net-global-overrides`" + ` ConfigMap, to a PEM encoded file.
+Use the file to trust the certificate on other machines or in other tools.
+`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Aliases: []string{"e"},
+	}
+
+	cmd.Flags().StringVarP(&o.Output, "output", "o", defaultOutput, "Path to the file to which the certificate is exported.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	var err error
+	if c.K8s, err = kube.NewFromConfig("", c.KubeconfigPath); err != nil {
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
+	}
+
+	s := c.NewStep("Exporting Kyma root certificate")
+	data, err := trust.NewCertifier(c.K8s).Certificate()
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	cert, err := trust.ParseCertificate(data)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	domain, err := trust.Domain(cert)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+
+	if err := ioutil.WriteFile(c.opts.Output, trust.EncodePEM(cert), 0644); err != nil {
+		s.Failure()
+		return errors.Wrapf(err, "Could not write the certificate to '%s'", c.opts.Output)
+	}
+	s.Successf("Kyma root certificate of '%s' (expires %s) exported to '%s'", domain, cert.NotAfter.Format("2006-01-02"), c.opts.Output)
+	return nil
+}
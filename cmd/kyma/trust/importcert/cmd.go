@@ -0,0 +1,96 @@
+package importcert
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new trust import command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Imports the Kyma root certificate of the current cluster.",
+		Long: `Use this command to trust the root certificate of the Kyma cluster of the current kubeconfig, or the certificate in the given file.
+Certificates imported earlier for the same domain are replaced.
+`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Aliases: []string{"i"},
+	}
+
+	cmd.Flags().StringSliceVar(&o.Stores, "store", []string{string(trust.SystemStore)}, "Stores into which the certificate is imported. Takes a comma-separated list of: "+strings.Join(trust.StoreNames(), ", ")+".")
+	cmd.Flags().StringVarP(&o.File, "file", "f", "", "Path to a PEM encoded certificate which is imported instead of the certificate of the current cluster.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	stores, err := trust.ParseStores(c.opts.Stores)
+	if err != nil {
+		return err
+	}
+
+	file := c.opts.File
+	if file == "" {
+		if c.K8s, err = kube.NewFromConfig("", c.KubeconfigPath); err != nil {
+			return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
+		}
+		if file, err = c.downloadCertificate(trust.NewCertifier(c.K8s)); err != nil {
+			return err
+		}
+		defer os.Remove(file)
+	}
+
+	s := c.NewStep("Importing Kyma root certificate")
+	if err := trust.NewCertifier(c.K8s, stores...).StoreCertificate(file, s); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Kyma root certificate imported")
+	return nil
+}
+
+// downloadCertificate stores the certificate of the cluster in a temporary file and returns its path.
+func (c *command) downloadCertificate(ca trust.Certifier) (string, error) {
+	s := c.NewStep("Downloading Kyma root certificate")
+	cert, err := ca.Certificate()
+	if err != nil {
+		s.Failure()
+		return "", err
+	}
+
+	tmpFile, err := ioutil.TempFile(os.TempDir(), "kyma-*.crt")
+	if err != nil {
+		s.Failure()
+		return "", errors.Wrap(err, "Cannot create temporary file for Kyma certificate")
+	}
+	if _, err = tmpFile.Write(cert); err != nil {
+		s.Failure()
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return "", errors.Wrap(err, "Failed to write the kyma certificate")
+	}
+	if err := tmpFile.Close(); err != nil {
+		s.Failure()
+		os.Remove(tmpFile.Name())
+		return "", err
+	}
+	s.Successf("Kyma root certificate downloaded")
+	return tmpFile.Name(), nil
+}
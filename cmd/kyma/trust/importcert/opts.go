@@ -0,0 +1,15 @@
+package importcert
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the trust import command
+type Options struct {
+	*cli.Options
+	Stores []string
+	File   string
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
@@ -0,0 +1,75 @@
+package list
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new trust list command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the trusted Kyma root certificates.",
+		Long: `Use this command to list the Kyma root certificates trusted on this machine, together with their domain, expiry date, and SHA-256 fingerprint.
+The command does not require a connection to a cluster.
+`,
+		RunE:    func(_ *cobra.Command, _ []string) error { return c.Run() },
+		Aliases: []string{"l"},
+	}
+
+	cmd.Flags().StringSliceVar(&o.Stores, "store", trust.StoreNames(), "Stores in which to look for certificates. Takes a comma-separated list of: "+strings.Join(trust.StoreNames(), ", ")+".")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run() error {
+	stores, err := trust.ParseStores(c.opts.Stores)
+	if err != nil {
+		return err
+	}
+
+	certs, _ := trust.ListReadable(stores, func(_ trust.Store, err error) {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", err)
+	})
+
+	if len(certs) == 0 {
+		fmt.Println("No trusted Kyma root certificates found")
+		return nil
+	}
+	printCertificates(certs, time.Now(), os.Stdout)
+	return nil
+}
+
+func printCertificates(certs []trust.TrustedCertificate, now time.Time, out io.Writer) {
+	sort.SliceStable(certs, func(i, j int) bool {
+		return certs[i].Domain < certs[j].Domain
+	})
+
+	writer := cli.NewTableWriter([]string{"DOMAIN", "STORE", "EXPIRES", "FINGERPRINT (SHA-256)", "LOCATION"}, out)
+	for _, c := range certs {
+		expires := c.Cert.NotAfter.Format("2006-01-02")
+		if now.After(c.Cert.NotAfter) {
+			expires += " (expired)"
+		}
+		writer.Append([]string{c.Domain, string(c.Store), expires, trust.Fingerprint(c.Cert), c.Location})
+	}
+	writer.Render()
+}
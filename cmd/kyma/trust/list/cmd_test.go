@@ -0,0 +1,32 @@
+package list
+
+import (
+	"bytes"
+	"crypto/x509"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintCertificates(t *testing.T) {
+	now := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	certs := []trust.TrustedCertificate{
+		{Domain: "my.cluster.com", Store: trust.UserStore, Location: "/home/user/.kyma/certs/kyma-my.cluster.com.crt", Cert: &x509.Certificate{Raw: []byte("cert1"), NotAfter: now.Add(24 * time.Hour)}},
+		{Domain: "kyma.local", Store: trust.SystemStore, Location: "/usr/local/share/ca-certificates/kyma-kyma.local.crt", Cert: &x509.Certificate{Raw: []byte("cert2"), NotAfter: now.Add(-24 * time.Hour)}},
+	}
+
+	out := &bytes.Buffer{}
+	printCertificates(certs, now, out)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3, "Output must contain a header and a line per certificate.")
+	require.Contains(t, lines[1], "kyma.local", "Certificates must be sorted by domain.")
+	require.Contains(t, lines[1], "2020-02-29 (expired)", "Expired certificates must be marked.")
+	require.Contains(t, lines[2], "my.cluster.com")
+	require.Contains(t, lines[2], "2020-03-02")
+	require.NotContains(t, lines[2], "expired")
+	require.Contains(t, lines[2], trust.Fingerprint(certs[1].Cert), "Fingerprint must be printed.")
+}
@@ -0,0 +1,14 @@
+package list
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the trust list command
+type Options struct {
+	*cli.Options
+	Stores []string
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
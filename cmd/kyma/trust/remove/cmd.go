@@ -0,0 +1,102 @@
+package remove
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type command struct {
+	opts *Options
+	cli.Command
+}
+
+//NewCmd creates a new trust remove command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command: cli.Command{Options: o.Options},
+		opts:    o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remove <domain>",
+		Short: "Removes trusted Kyma root certificates.",
+		Long: `Use this command to remove the trusted Kyma root certificate of the given domain, or all trusted Kyma root certificates with the "--all" flag.
+To see which certificates are trusted, run ` + "`kyma trust list`" + `.
+`,
+		RunE:    func(_ *cobra.Command, args []string) error { return c.Run(args) },
+		Aliases: []string{"rm"},
+	}
+
+	cmd.Flags().StringSliceVar(&o.Stores, "store", trust.StoreNames(), "Stores from which certificates are removed. Takes a comma-separated list of: "+strings.Join(trust.StoreNames(), ", ")+".")
+	cmd.Flags().BoolVar(&o.All, "all", false, "Removes all trusted Kyma root certificates.")
+	return cmd
+}
+
+//Run runs the command
+func (c *command) Run(args []string) error {
+	if err := c.validateArgs(args); err != nil {
+		return err
+	}
+	stores, err := trust.ParseStores(c.opts.Stores)
+	if err != nil {
+		return err
+	}
+
+	// stores which can't be read are skipped, so that the certificates can still be removed from the other ones
+	trusted, readable := trust.ListReadable(stores, func(s trust.Store, err error) {
+		fmt.Fprintf(os.Stderr, "Warning: %s. Skipping the %s store.\n", err, s)
+	})
+	ca := trust.NewCertifier(nil, readable...)
+
+	domains, err := domainsToRemove(trusted, args, c.opts.All)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range domains {
+		s := c.NewStep(fmt.Sprintf("Removing Kyma root certificate of '%s'", d))
+		if err := ca.RemoveCertificate(d, s); err != nil {
+			s.Failure()
+			return err
+		}
+		s.Successf("Kyma root certificate of '%s' removed", d)
+	}
+	return nil
+}
+
+func (c *command) validateArgs(args []string) error {
+	switch {
+	case c.opts.All && len(args) > 0:
+		return errors.New("Provide either a domain or the \"--all\" flag, not both")
+	case !c.opts.All && len(args) != 1:
+		return errors.New("Provide the domain of the certificate to remove, or the \"--all\" flag")
+	}
+	return nil
+}
+
+// domainsToRemove returns the domains of the trusted certificates which are to be removed, each one only once.
+func domainsToRemove(trusted []trust.TrustedCertificate, args []string, all bool) ([]string, error) {
+	var domains []string
+	seen := map[string]bool{}
+	for _, t := range trusted {
+		if seen[t.Domain] || (!all && t.Domain != args[0]) {
+			continue
+		}
+		seen[t.Domain] = true
+		domains = append(domains, t.Domain)
+	}
+
+	if len(domains) == 0 {
+		if all {
+			return nil, errors.New("No trusted Kyma root certificates found")
+		}
+		return nil, fmt.Errorf("No trusted Kyma root certificate found for domain '%s'", args[0])
+	}
+	return domains, nil
+}
@@ -0,0 +1,58 @@
+package remove
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/trust"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRemoveFlags ensures that the provided command flags are stored in the options.
+func TestRemoveFlags(t *testing.T) {
+	o := NewOptions(&cli.Options{})
+	c := NewCmd(o)
+
+	// test default flag values
+	require.Equal(t, []string{"system", "nss", "user"}, o.Stores, "Default value for the store flag not as expected.")
+	require.False(t, o.All, "Default value for the all flag not as expected.")
+
+	// test passing flags
+	err := c.ParseFlags([]string{"--store", "user", "--all"})
+	require.NoError(t, err, "Parsing flags should not return an error")
+	require.Equal(t, []string{"user"}, o.Stores, "The parsed value for the store flag not as expected.")
+	require.True(t, o.All, "The parsed value for the all flag not as expected.")
+}
+
+func TestValidateArgs(t *testing.T) {
+	c := command{opts: NewOptions(&cli.Options{})}
+	require.NoError(t, c.validateArgs([]string{"kyma.local"}))
+	require.Error(t, c.validateArgs(nil), "A domain or the all flag is required.")
+	require.Error(t, c.validateArgs([]string{"kyma.local", "other.domain"}), "Only one domain can be removed at once.")
+
+	c.opts.All = true
+	require.NoError(t, c.validateArgs(nil))
+	require.Error(t, c.validateArgs([]string{"kyma.local"}), "A domain and the all flag are mutually exclusive.")
+}
+
+func TestDomainsToRemove(t *testing.T) {
+	trusted := []trust.TrustedCertificate{
+		{Domain: "kyma.local", Store: trust.SystemStore},
+		{Domain: "kyma.local", Store: trust.UserStore},
+		{Domain: "my.cluster.com", Store: trust.UserStore},
+	}
+
+	domains, err := domainsToRemove(trusted, []string{"kyma.local"}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"kyma.local"}, domains, "Each domain must be removed only once.")
+
+	domains, err = domainsToRemove(trusted, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"kyma.local", "my.cluster.com"}, domains, "All domains must be removed.")
+
+	_, err = domainsToRemove(trusted, []string{"unknown.domain"}, false)
+	require.Error(t, err, "Removing a domain which is not trusted must fail.")
+
+	_, err = domainsToRemove(nil, nil, true)
+	require.Error(t, err, "Removing all certificates when none is trusted must fail.")
+}
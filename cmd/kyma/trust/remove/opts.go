@@ -0,0 +1,15 @@
+package remove
+
+import "github.com/kyma-project/cli/internal/cli"
+
+//Options defines available options for the trust remove command
+type Options struct {
+	*cli.Options
+	Stores []string
+	All    bool
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o}
+}
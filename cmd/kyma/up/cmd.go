@@ -0,0 +1,237 @@
+package up
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+
+	hf "github.com/kyma-incubator/hydroform/provision"
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/cmd/kyma/install"
+	"github.com/kyma-project/cli/cmd/kyma/provision/azure"
+	"github.com/kyma-project/cli/cmd/kyma/provision/gardener"
+	"github.com/kyma-project/cli/cmd/kyma/provision/gcp"
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/kyma-project/cli/internal/clusters"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	phaseProvision = "provision"
+	phaseInstall   = "install"
+	phaseVerify    = "verify"
+)
+
+// verifiedNamespaces are the namespaces whose deployments must be ready after the installation
+var verifiedNamespaces = []string{"istio-system", "kyma-system"}
+
+var installationResource = schema.GroupVersionResource{
+	Group:    "installer.kyma-project.io",
+	Version:  "v1alpha1",
+	Resource: "installations",
+}
+
+type command struct {
+	opts       *Options
+	installCmd *cobra.Command
+	cli.Command
+}
+
+//NewCmd creates a new up command
+func NewCmd(o *Options) *cobra.Command {
+	c := command{
+		Command:    cli.Command{Options: o.Options},
+		opts:       o,
+		installCmd: install.NewCmd(o.Install),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Provisions a cluster and installs Kyma on it in one run.",
+		Long: `Use this command to provision the cluster defined in a cluster file, import its kubeconfig, install Kyma with the given installation flags, and verify the installation.
+
+The run is resumable. The completed phases are stored with the cluster state in the Kyma CLI home directory, and running the command again with the same cluster file continues with the first phase which did not complete.
+Use ` + "`kyma down`" + ` with the same cluster file to remove the cluster again.`,
+		RunE: func(_ *cobra.Command, _ []string) error { return c.Run() },
+	}
+
+	cmd.Flags().StringVarP(&o.File, "file", "f", "", "Path to the cluster file defining the cluster to provision. (required)")
+	cmd.Flags().BoolVar(&o.Restart, "restart", false, "Runs all phases again, instead of continuing a previous run.")
+	cmd.Flags().BoolVar(&o.SkipVerify, "skip-verify", false, "Skips the verification of the installation.")
+	cmd.Flags().DurationVar(&o.VerifyTimeout, "verify-timeout", 10*time.Minute, "Time-out after which the verification of the installation fails.")
+	// the installation flags are bound to the options of the install command
+	cmd.Flags().AddFlagSet(c.installCmd.Flags())
+
+	return cmd
+}
+
+func (c *command) Run() error {
+	if c.opts.File == "" {
+		return errors.New("Required flag `file` has not been set.")
+	}
+	f, err := clusters.LoadFile(c.opts.File)
+	if err != nil {
+		return err
+	}
+	if f.Name == "" || f.Project == "" {
+		return fmt.Errorf("the cluster file %s must define the name and project of the cluster", c.opts.File)
+	}
+	home, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+
+	p, err := loadProgress(clusters.Dir(home, f.Provider, f.Project, f.Name))
+	if err != nil {
+		return err
+	}
+	if c.opts.Restart {
+		if err := p.reset(); err != nil {
+			return err
+		}
+	}
+
+	verifySkip := ""
+	switch {
+	case c.opts.SkipVerify:
+		verifySkip = "the verification is disabled"
+	case c.opts.Install.NoWait:
+		verifySkip = "the installation was not awaited"
+	}
+	phases := []phase{
+		{name: phaseProvision, run: func() error { return c.provision(f) }},
+		{name: phaseInstall, run: c.install},
+		{name: phaseVerify, run: c.verify, skip: verifySkip},
+	}
+
+	if p.done(phaseProvision) {
+		// the kubeconfig of a previous run may not be the current one anymore
+		if err := c.importKubeconfig(home, f); err != nil {
+			return err
+		}
+	}
+
+	results, err := runPhases(phases, p, time.Now)
+	printSummary(f, results)
+	if err != nil {
+		if c.Interrupted() {
+			return errors.Wrap(err, "Interrupted, run the command again to continue")
+		}
+		return errors.Wrap(err, "Run the command again to continue with the failed phase")
+	}
+	return nil
+}
+
+// provision runs the provision command of the provider of the cluster file
+func (c *command) provision(f *clusters.File) error {
+	var cmd *cobra.Command
+	switch f.Provider {
+	case types.GCP:
+		cmd = gcp.NewCmd(gcp.NewOptions(c.opts.Options))
+	case types.Azure:
+		cmd = azure.NewCmd(azure.NewOptions(c.opts.Options))
+	case types.Gardener:
+		cmd = gardener.NewCmd(gardener.NewOptions(c.opts.Options))
+	default:
+		return fmt.Errorf("provider %s is not supported", f.Provider)
+	}
+	if err := cmd.Flags().Set("file", c.opts.File); err != nil {
+		return err
+	}
+	return cmd.RunE(cmd, nil)
+}
+
+func (c *command) install() error {
+	return c.installCmd.RunE(c.installCmd, nil)
+}
+
+// importKubeconfig makes the kubeconfig point to the cluster provisioned in a previous run
+func (c *command) importKubeconfig(home string, f *clusters.File) error {
+	s := c.NewStep("Importing kubeconfig")
+	state, err := clusters.Find(home, f.Provider, f.Project, f.Name)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	cluster, provider, err := state.Hydroform(f.Credentials)
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	if !c.opts.Verbose {
+		// discard all the noise from terraform logs if not verbose
+		log.SetOutput(ioutil.Discard)
+	}
+	kubeconfig, err := hf.Credentials(cluster, provider, types.WithDataDir(home), types.Persistent())
+	if err != nil {
+		s.Failure()
+		return err
+	}
+	if err := kube.AppendConfig(kubeconfig, c.opts.KubeconfigPath); err != nil {
+		s.Failure()
+		return err
+	}
+	s.Successf("Kubeconfig of the cluster provisioned in a previous run imported")
+	return nil
+}
+
+// verify checks that Kyma is installed and all its deployments are ready
+func (c *command) verify() error {
+	s := c.NewStep("Verifying installation")
+	var err error
+	if c.K8s, err = kube.NewFromConfig("", c.opts.KubeconfigPath); err != nil {
+		s.Failure()
+		return errors.Wrap(err, "Could not initialize the Kubernetes client. Make sure your kubeconfig is valid")
+	}
+
+	inst, err := c.K8s.Dynamic().Resource(installationResource).Namespace("default").Get("kyma-installation", metav1.GetOptions{})
+	if err != nil {
+		s.Failure()
+		return errors.Wrap(err, "while reading the installation status")
+	}
+	if state, _, _ := unstructured.NestedString(inst.Object, "status", "state"); state != "Installed" {
+		s.Failure()
+		return fmt.Errorf("the installation is in state %q instead of Installed", state)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), c.opts.VerifyTimeout)
+	defer cancel()
+	for _, ns := range verifiedNamespaces {
+		deployments, err := c.K8s.Static().AppsV1().Deployments(ns).List(metav1.ListOptions{})
+		if err != nil {
+			s.Failure()
+			return errors.Wrapf(err, "while listing the deployments in %s", ns)
+		}
+		for _, d := range deployments.Items {
+			s.Status(fmt.Sprintf("%s/%s", ns, d.Name))
+			if err := c.K8s.WaitDeploymentReady(ctx, ns, d.Name, 0); err != nil {
+				s.Failure()
+				return err
+			}
+		}
+	}
+	s.Successf("Installation verified")
+	return nil
+}
+
+func printSummary(f *clusters.File, results []phaseResult) {
+	fmt.Printf("\nSummary of the %s cluster '%s' in '%s':\n", f.Provider, f.Name, f.Project)
+	writer := cli.NewTableWriter([]string{"PHASE", "RESULT", "DURATION"}, os.Stdout)
+	for _, r := range results {
+		duration := ""
+		if r.duration > 0 {
+			duration = r.duration.String()
+		}
+		writer.Append([]string{r.name, r.result, duration})
+	}
+	writer.Render()
+}
@@ -0,0 +1,25 @@
+package up
+
+import (
+	"time"
+
+	"github.com/kyma-project/cli/cmd/kyma/install"
+	"github.com/kyma-project/cli/internal/cli"
+)
+
+//Options defines available options for the command
+type Options struct {
+	*cli.Options
+
+	File          string
+	Restart       bool
+	SkipVerify    bool
+	VerifyTimeout time.Duration
+	// Install holds the options of the Kyma installation, which are bound to the install flags of the command
+	Install *install.Options
+}
+
+//NewOptions creates options with default values
+func NewOptions(o *cli.Options) *Options {
+	return &Options{Options: o, Install: install.NewOptions(o)}
+}
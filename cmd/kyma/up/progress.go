@@ -0,0 +1,116 @@
+package up
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// progressFile records the phases of a run which completed, it is stored next to the hydroform state of the cluster
+const progressFile = "kyma-up.json"
+
+type progress struct {
+	// Phases holds the time each completed phase finished
+	Phases map[string]time.Time `json:"phases"`
+	dir    string
+}
+
+// loadProgress reads the progress of a previous run in the cluster directory, no progress is returned if there was no run
+func loadProgress(dir string) (*progress, error) {
+	p := &progress{Phases: map[string]time.Time{}, dir: dir}
+	data, err := ioutil.ReadFile(filepath.Join(dir, progressFile))
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the progress of the previous run")
+	}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, errors.Wrap(err, "while reading the progress of the previous run")
+	}
+	if p.Phases == nil {
+		p.Phases = map[string]time.Time{}
+	}
+	return p, nil
+}
+
+func (p *progress) done(phase string) bool {
+	_, ok := p.Phases[phase]
+	return ok
+}
+
+// complete records the phase as completed and persists the progress
+func (p *progress) complete(phase string, at time.Time) error {
+	p.Phases[phase] = at
+	if err := os.MkdirAll(p.dir, 0700); err != nil {
+		return errors.Wrap(err, "while saving the progress")
+	}
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "while saving the progress")
+	}
+	return errors.Wrap(ioutil.WriteFile(filepath.Join(p.dir, progressFile), data, 0600), "while saving the progress")
+}
+
+// reset forgets all completed phases, so the next run starts from the beginning
+func (p *progress) reset() error {
+	p.Phases = map[string]time.Time{}
+	if err := os.Remove(filepath.Join(p.dir, progressFile)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "while resetting the progress")
+	}
+	return nil
+}
+
+// phase is a step of the run which is skipped if it completed in a previous run
+type phase struct {
+	name string
+	run  func() error
+	// skip tells why the phase is not needed in this run, an empty reason means the phase runs
+	skip string
+}
+
+// phaseResult is the outcome of a phase shown in the summary
+type phaseResult struct {
+	name     string
+	result   string
+	duration time.Duration
+}
+
+// runPhases runs the phases in order, skipping the ones which already completed, and stops at the first failing phase.
+// The results of all phases are returned, including the ones which did not run because of the failure.
+func runPhases(phases []phase, p *progress, now func() time.Time) ([]phaseResult, error) {
+	var results []phaseResult
+	var failure error
+	for _, ph := range phases {
+		switch {
+		case failure != nil:
+			results = append(results, phaseResult{name: ph.name, result: "Not run"})
+			continue
+		case p.done(ph.name):
+			results = append(results, phaseResult{name: ph.name, result: "Completed in a previous run"})
+			continue
+		case ph.skip != "":
+			results = append(results, phaseResult{name: ph.name, result: "Skipped, " + ph.skip})
+			continue
+		}
+
+		start := now()
+		err := ph.run()
+		r := phaseResult{name: ph.name, duration: now().Sub(start).Round(time.Second)}
+		if err != nil {
+			r.result = "Failed"
+			failure = errors.Wrapf(err, "%s failed", ph.name)
+		} else {
+			r.result = "Completed"
+			if err := p.complete(ph.name, now()); err != nil {
+				failure = err
+			}
+		}
+		results = append(results, r)
+	}
+	return results, failure
+}
@@ -0,0 +1,70 @@
+package up
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunPhases(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kyma-up")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2020, 4, 10, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	var ran []string
+	run := func(name string, err error) func() error {
+		return func() error {
+			ran = append(ran, name)
+			return err
+		}
+	}
+
+	p, err := loadProgress(dir)
+	require.NoError(t, err)
+	results, err := runPhases([]phase{
+		{name: phaseProvision, run: run(phaseProvision, nil)},
+		{name: phaseInstall, run: run(phaseInstall, errors.New("timeout"))},
+		{name: phaseVerify, run: run(phaseVerify, nil)},
+	}, p, clock)
+	require.EqualError(t, err, "install failed: timeout")
+	require.Equal(t, []string{phaseProvision, phaseInstall}, ran)
+	require.Equal(t, []phaseResult{
+		{name: phaseProvision, result: "Completed", duration: time.Minute},
+		{name: phaseInstall, result: "Failed", duration: time.Minute},
+		{name: phaseVerify, result: "Not run"},
+	}, results)
+
+	// a second run continues with the failed phase
+	ran = nil
+	p, err = loadProgress(dir)
+	require.NoError(t, err)
+	results, err = runPhases([]phase{
+		{name: phaseProvision, run: run(phaseProvision, nil)},
+		{name: phaseInstall, run: run(phaseInstall, nil)},
+		{name: phaseVerify, run: run(phaseVerify, nil), skip: "the verification is disabled"},
+	}, p, clock)
+	require.NoError(t, err)
+	require.Equal(t, []string{phaseInstall}, ran)
+	require.Equal(t, "Completed in a previous run", results[0].result)
+	require.Equal(t, "Skipped, the verification is disabled", results[2].result)
+
+	p, err = loadProgress(dir)
+	require.NoError(t, err)
+	require.True(t, p.done(phaseInstall))
+	require.False(t, p.done(phaseVerify))
+
+	require.NoError(t, p.reset())
+	p, err = loadProgress(dir)
+	require.NoError(t, err)
+	require.Empty(t, p.Phases)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -11,22 +12,36 @@ import (
 )
 
 func main() {
-	setupCloseHandler()
-	command := kyma.NewCmd(cli.NewOptions())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	setupCloseHandler(cancel)
+
+	o := cli.NewOptions()
+	o.Ctx = ctx
+	command := kyma.NewCmd(o)
 
 	err := command.Execute()
 	if err != nil {
-		os.Exit(1)
+		if ctx.Err() == context.Canceled {
+			os.Exit(cli.ExitCodeInterrupted)
+		}
+		os.Exit(cli.ExitCode(err))
 	}
 
 }
 
-func setupCloseHandler() {
+// setupCloseHandler cancels the root context on the first interrupt signal so that running commands can stop cleanly.
+// A second signal terminates the CLI immediately.
+func setupCloseHandler(cancel context.CancelFunc) {
 	c := make(chan os.Signal, 2)
 	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
 	go func() {
 		sig := <-c
-		fmt.Printf("\r- Signal '%v' received from Terminal. Exiting...\n ", sig)
-		os.Exit(0)
+		fmt.Printf("\r- Signal '%v' received from Terminal. Stopping the current operation, send the signal again to exit immediately...\n", sig)
+		cancel()
+
+		sig = <-c
+		fmt.Printf("\r- Signal '%v' received from Terminal. Exiting...\n", sig)
+		os.Exit(cli.ExitCodeInterrupted)
 	}()
 }
@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/kyma-project/cli/internal/kube"
 	"github.com/kyma-project/cli/internal/kubectl"
 	"github.com/kyma-project/cli/pkg/step"
 )
 
+// ExitCodeInterrupted is the exit code of the CLI when a command was stopped by an interrupt signal.
+const ExitCodeInterrupted = 130
+
 type Command struct {
 	*Options
 	CurrentStep step.Step
@@ -25,3 +30,34 @@ func (c *Command) Kubectl() *kubectl.Wrapper {
 	}
 	return c.kubectl
 }
+
+// Context returns the root context of the command, which is cancelled when the CLI gets interrupted.
+// Long running operations must stop as soon as the context is done.
+func (c *Command) Context() context.Context {
+	if c.Options.Ctx == nil {
+		return context.Background()
+	}
+	return c.Options.Ctx
+}
+
+// Interrupted tells if the command was stopped by an interrupt signal.
+func (c *Command) Interrupted() bool {
+	return c.Context().Err() == context.Canceled
+}
+
+// Interruptible runs an operation which cannot be cancelled itself, such as provisioning with hydroform.
+// If the command gets interrupted before the operation finishes, it returns right away with the context error
+// and leaves the operation unfinished.
+func (c *Command) Interruptible(op func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- op()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-c.Context().Done():
+		return c.Context().Err()
+	}
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -35,3 +37,22 @@ func TestKubectl(t *testing.T) {
 	require.NotNil(t, k, "Kubectl wrapper should be initialized on demand when getter is called.")
 
 }
+
+func TestInterruptible(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := Command{Options: &Options{Ctx: ctx}}
+
+	// test finished operation
+	opErr := errors.New("operation failed")
+	require.Equal(t, opErr, c.Interruptible(func() error { return opErr }), "Error of the operation should be returned.")
+	require.False(t, c.Interrupted(), "Command should not be interrupted before the context is cancelled.")
+
+	// test interrupted operation
+	block := make(chan struct{})
+	defer close(block)
+	cancel()
+	err := c.Interruptible(func() error { <-block; return nil })
+
+	require.Equal(t, context.Canceled, err, "Interrupted operation should return the context error.")
+	require.True(t, c.Interrupted(), "Command should be interrupted after the context is cancelled.")
+}
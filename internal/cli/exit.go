@@ -0,0 +1,55 @@
+package cli
+
+import "fmt"
+
+const (
+	// ExitCodeFailure is the exit code of the CLI when a command failed, for example because tests failed.
+	ExitCodeFailure = 1
+	// ExitCodeError is the exit code of the CLI when a command could not complete, for example because of a timeout or an infrastructure error.
+	ExitCodeError = 2
+)
+
+// ExitError is an error which makes the CLI exit with a specific exit code.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// NewExitError returns an error which makes the CLI exit with the given code.
+func NewExitError(code int, err error) *ExitError {
+	return &ExitError{Code: code, Err: err}
+}
+
+// NewExitErrorf returns an error with the formatted message which makes the CLI exit with the given code.
+func NewExitErrorf(code int, format string, args ...interface{}) *ExitError {
+	return NewExitError(code, fmt.Errorf(format, args...))
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Cause returns the underlying error.
+func (e *ExitError) Cause() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code for the error returned by a command. It finds exit errors also if they are wrapped,
+// and defaults to ExitCodeFailure for all other errors.
+func ExitCode(err error) int {
+	type causer interface {
+		Cause() error
+	}
+
+	for err != nil {
+		if e, ok := err.(*ExitError); ok {
+			return e.Code
+		}
+		c, ok := err.(causer)
+		if !ok {
+			break
+		}
+		err = c.Cause()
+	}
+	return ExitCodeFailure
+}
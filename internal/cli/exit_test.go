@@ -0,0 +1,16 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExitCode(t *testing.T) {
+	require.Equal(t, ExitCodeFailure, ExitCode(fmt.Errorf("plain error")))
+	require.Equal(t, ExitCodeError, ExitCode(NewExitErrorf(ExitCodeError, "timeout")))
+	require.Equal(t, ExitCodeError, ExitCode(errors.Wrap(NewExitErrorf(ExitCodeError, "timeout"), "wrapped")), "Wrapped exit errors must keep their code.")
+	require.Equal(t, ExitCodeFailure, ExitCode(NewExitError(ExitCodeFailure, errors.New("tests failed"))))
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+
 	"github.com/kyma-project/cli/pkg/step"
 )
 
@@ -10,9 +12,11 @@ type Options struct {
 	Verbose bool
 	step.Factory
 	KubeconfigPath string
+	// Ctx is the root context of the CLI, it is cancelled when the CLI receives an interrupt signal.
+	Ctx context.Context
 }
 
 //NewOptions creates options with default values
 func NewOptions() *Options {
-	return &Options{}
+	return &Options{Ctx: context.Background()}
 }
@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"io"
+
+	"github.com/olekukonko/tablewriter"
+)
+
+// NewTableWriter creates a borderless, left aligned table writer with the given columns, as used by the list commands of the CLI.
+func NewTableWriter(columns []string, out io.Writer) *tablewriter.Table {
+	writer := tablewriter.NewWriter(out)
+	writer.SetBorder(false)
+	writer.SetHeader(columns)
+	writer.SetAlignment(tablewriter.ALIGN_LEFT)
+	writer.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	writer.SetHeaderLine(false)
+	writer.SetRowSeparator("")
+	writer.SetCenterSeparator("")
+	writer.SetColumnSeparator("")
+	return writer
+}
@@ -0,0 +1,292 @@
+// Package clusters gives access to the clusters provisioned by the CLI, based on the state hydroform persists in the Kyma home directory.
+package clusters
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// clustersDir is the directory inside the data directory in which hydroform stores one directory per cluster,
+	// following the layout clusters/<provider>/<project>/<cluster>
+	clustersDir = "clusters"
+	varsFile    = "terraform.tfvars"
+	stateFile   = "terraform.tfstate"
+)
+
+// keys of the hydroform configuration which are computed by hydroform itself and must not be passed as custom configurations
+var computedKeys = map[string]bool{
+	"create_timeout": true,
+	"update_timeout": true,
+	"delete_timeout": true,
+	"namespace":      true,
+	"target_profile": true,
+	"zoned":          true,
+	"resource_group": true,
+}
+
+// Cluster is a cluster which was provisioned with hydroform and whose state is persisted in the data directory
+type Cluster struct {
+	Name     string
+	Project  string
+	Provider types.ProviderType
+	// Dir is the directory holding the persisted state of the cluster
+	Dir string
+	// Vars is the hydroform configuration the cluster was provisioned with
+	Vars map[string]interface{}
+	// Created is the time when the cluster was provisioned
+	Created time.Time
+	// TTL is the time to live of the cluster, it is 0 if the cluster has no TTL
+	TTL time.Duration
+
+	metadata Metadata
+}
+
+// Find returns the persisted cluster of the provider with the given name.
+// The provider and project are optional, they are only needed if several clusters with the same name exist.
+func Find(dataDir string, provider types.ProviderType, project, name string) (*Cluster, error) {
+	providerPattern, projectPattern := string(provider), project
+	if providerPattern == "" {
+		providerPattern = "*"
+	}
+	if projectPattern == "" {
+		projectPattern = "*"
+	}
+	files, err := filepath.Glob(filepath.Join(dataDir, clustersDir, providerPattern, projectPattern, name, varsFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "while looking for the cluster state")
+	}
+	sort.Strings(files)
+
+	var found []*Cluster
+	for _, f := range files {
+		c, err := load(filepath.Dir(f))
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, c)
+	}
+
+	switch len(found) {
+	case 0:
+		if provider == "" {
+			return nil, fmt.Errorf("no state of the cluster '%s' found in %s", name, dataDir)
+		}
+		return nil, fmt.Errorf("no state of the %s cluster '%s' found in %s", provider, name, dataDir)
+	case 1:
+		return found[0], nil
+	default:
+		var locations []string
+		for _, c := range found {
+			locations = append(locations, fmt.Sprintf("%s/%s", c.Provider, c.Project))
+		}
+		return nil, fmt.Errorf("the cluster '%s' exists several times (%s), select one with the provider or project flag", name, strings.Join(locations, ", "))
+	}
+}
+
+// List returns all persisted clusters of all providers sorted by provider, project and name
+func List(dataDir string) ([]*Cluster, error) {
+	files, err := filepath.Glob(filepath.Join(dataDir, clustersDir, "*", "*", "*", varsFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "while looking for cluster states")
+	}
+	sort.Strings(files)
+
+	var result []*Cluster
+	for _, f := range files {
+		c, err := load(filepath.Dir(f))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+func load(dir string) (*Cluster, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, varsFile))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the configuration of the cluster in %s", dir)
+	}
+
+	c := &Cluster{
+		Name:     filepath.Base(dir),
+		Project:  filepath.Base(filepath.Dir(dir)),
+		Provider: types.ProviderType(filepath.Base(filepath.Dir(filepath.Dir(dir)))),
+		Dir:      dir,
+		Vars:     parseVars(string(data)),
+	}
+	if err := c.loadMetadata(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Dir returns the directory in which hydroform persists the state of the cluster, the directory may not exist yet
+func Dir(dataDir string, provider types.ProviderType, project, name string) string {
+	return filepath.Join(dataDir, clustersDir, string(provider), project, name)
+}
+
+// HasState tells if hydroform persisted a terraform state for the cluster, which is needed to deprovision it.
+// EKS clusters keep their state in CloudFormation, and the CLI only persists them once they are provisioned.
+func (c *Cluster) HasState() bool {
+	if c.Provider == types.AWS {
+		return true
+	}
+	_, err := os.Stat(filepath.Join(c.Dir, stateFile))
+	return err == nil
+}
+
+// Spec returns the current specification of the cluster, including the node count of a GKE cluster resized after provisioning
+func (c *Cluster) Spec() *types.Cluster {
+	cluster, vars := c.spec()
+	if resized, _ := strconv.Atoi(fmt.Sprint(vars[resizedNodeCountVar])); resized > 0 {
+		cluster.NodeCount = resized
+	}
+	return cluster
+}
+
+// spec returns the specification of the cluster and the hydroform configuration which is not part of it
+func (c *Cluster) spec() (*types.Cluster, map[string]interface{}) {
+	vars := map[string]interface{}{}
+	for k, v := range c.Vars {
+		vars[k] = v
+	}
+	take := func(key string) string {
+		v, _ := vars[key].(string)
+		delete(vars, key)
+		return v
+	}
+	takeInt := func(key string) int {
+		i, _ := strconv.Atoi(take(key))
+		return i
+	}
+
+	cluster := &types.Cluster{}
+	switch c.Provider {
+	case types.Azure:
+		cluster.NodeCount = takeInt("agent_count")
+		cluster.MachineType = take("agent_vm_size")
+		cluster.DiskSizeGB = takeInt("agent_disk_size")
+	default:
+		cluster.NodeCount = takeInt("node_count")
+		cluster.MachineType = take("machine_type")
+		cluster.DiskSizeGB = takeInt("disk_size")
+	}
+	cluster.Name = take("cluster_name")
+	cluster.KubernetesVersion = take("kubernetes_version")
+	cluster.Location = take("location")
+	take("project")
+	take("profile")
+	if cluster.Name == "" {
+		cluster.Name = c.Name
+	}
+	return cluster, vars
+}
+
+// Hydroform returns the cluster and provider to pass to hydroform for managing the persisted cluster.
+// The credentials file replaces the one the cluster was provisioned with, it is optional unless the path was not persisted.
+func (c *Cluster) Hydroform(credentials string) (*types.Cluster, *types.Provider, error) {
+	cluster, vars := c.spec()
+	provider := &types.Provider{
+		Type:        c.Provider,
+		ProjectName: c.Project,
+	}
+
+	provider.CredentialsFilePath, _ = vars["credentials_file_path"].(string)
+	delete(vars, "credentials_file_path")
+	if credentials != "" {
+		provider.CredentialsFilePath = credentials
+	}
+	if provider.CredentialsFilePath == "" {
+		return nil, nil, fmt.Errorf("the credentials file of the %s cluster '%s' is not persisted, provide it with the credentials flag", c.Provider, c.Name)
+	}
+	if c.Provider == types.Azure && credentials != "" {
+		// the secrets read from the new credentials file must not be replaced by the persisted ones
+		for _, k := range []string{"subscription_id", "tenant_id", "client_id", "client_secret"} {
+			delete(vars, k)
+		}
+	}
+
+	provider.CustomConfigurations = map[string]interface{}{}
+	for k, v := range vars {
+		if !computedKeys[k] {
+			provider.CustomConfigurations[k] = v
+		}
+	}
+
+	return cluster, provider, nil
+}
+
+// Overdue tells if the cluster lives longer than its TTL
+func (c *Cluster) Overdue(now time.Time) bool {
+	return c.TTL > 0 && now.After(c.Created.Add(c.TTL))
+}
+
+// DescribeTTL describes the time to live of the cluster and flags it if the cluster is overdue for deletion
+func (c *Cluster) DescribeTTL(now time.Time) string {
+	switch {
+	case c.TTL == 0:
+		return "None"
+	case c.Overdue(now):
+		return fmt.Sprintf("%s (overdue since %s)", c.TTL, now.Sub(c.Created.Add(c.TTL)).Round(time.Minute))
+	default:
+		return c.TTL.String()
+	}
+}
+
+// Remove deletes the persisted state of the cluster
+func (c *Cluster) Remove() error {
+	if err := os.RemoveAll(c.Dir); err != nil {
+		return errors.Wrapf(err, "while removing the state of the cluster in %s", c.Dir)
+	}
+	// remove the project directory as well if this was its last cluster
+	project := filepath.Dir(c.Dir)
+	if entries, err := ioutil.ReadDir(project); err == nil && len(entries) == 0 {
+		_ = os.Remove(project)
+	}
+	return nil
+}
+
+// parseVars reads the variables hydroform writes into the terraform variables file.
+// Hydroform writes every value either as a quoted string or as a list of quoted strings, one variable per line.
+func parseVars(data string) map[string]interface{} {
+	vars := map[string]interface{}{}
+	for _, line := range strings.Split(data, "\n") {
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		value := strings.TrimSpace(parts[1])
+
+		if strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+			list := []string{}
+			for _, item := range strings.Split(strings.Trim(value, "[]"), ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					list = append(list, unquote(item))
+				}
+			}
+			vars[key] = list
+			continue
+		}
+		vars[key] = unquote(value)
+	}
+	return vars
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,233 @@
+package clusters
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/stretchr/testify/require"
+)
+
+const gardenerVars = `cluster_name = "my-cluster"
+node_count = "3"
+machine_type = "n1-standard-4"
+disk_size = "30"
+kubernetes_version = "1.16"
+location = "europe-west3"
+project = "my-project"
+namespace = "garden-my-project"
+credentials_file_path = "/path/to/kubeconfig"
+target_provider = "gcp"
+target_profile = "gcp"
+worker_minimum = "2"
+zones = ["europe-west3-a","europe-west3-b"]
+create_timeout = "30m0s"
+`
+
+const azureVars = `cluster_name = "aks"
+agent_count = "2"
+agent_vm_size = "Standard_D4_v3"
+agent_disk_size = "50"
+kubernetes_version = "1.15"
+location = "westeurope"
+project = "rg"
+resource_group = "rg"
+subscription_id = "sub"
+tenant_id = "tenant"
+client_id = "client"
+client_secret = "secret"
+`
+
+func writeCluster(t *testing.T, home string, provider types.ProviderType, project, name, vars string) {
+	dir := filepath.Join(home, "clusters", string(provider), project, name)
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, varsFile), []byte(vars), 0600))
+}
+
+func TestFind(t *testing.T) {
+	home, err := ioutil.TempDir("", "clusters")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	writeCluster(t, home, types.Gardener, "my-project", "my-cluster", gardenerVars)
+	writeCluster(t, home, types.GCP, "p1", "twin", "")
+	writeCluster(t, home, types.GCP, "p2", "twin", "")
+
+	c, err := Find(home, types.Gardener, "", "my-cluster")
+	require.NoError(t, err)
+	require.Equal(t, "my-project", c.Project)
+	require.False(t, c.HasState())
+
+	_, err = Find(home, types.GCP, "", "my-cluster")
+	require.Error(t, err)
+
+	_, err = Find(home, types.GCP, "", "twin")
+	require.Error(t, err)
+	c, err = Find(home, types.GCP, "p2", "twin")
+	require.NoError(t, err)
+	require.Equal(t, "p2", c.Project)
+
+	all, err := List(home)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, types.Gardener, all[0].Provider)
+	require.Equal(t, types.GCP, all[2].Provider)
+
+	require.NoError(t, c.Remove())
+	_, err = os.Stat(filepath.Join(home, "clusters", "gcp", "p2"))
+	require.True(t, os.IsNotExist(err), "empty project directory should be removed")
+}
+
+func TestCluster_Hydroform(t *testing.T) {
+	t.Run("gardener", func(t *testing.T) {
+		c := &Cluster{Name: "my-cluster", Project: "my-project", Provider: types.Gardener, Vars: parseVars(gardenerVars)}
+
+		cluster, provider, err := c.Hydroform("")
+		require.NoError(t, err)
+		require.Equal(t, &types.Cluster{
+			Name:              "my-cluster",
+			KubernetesVersion: "1.16",
+			DiskSizeGB:        30,
+			NodeCount:         3,
+			MachineType:       "n1-standard-4",
+			Location:          "europe-west3",
+		}, cluster)
+		require.Equal(t, &types.Provider{
+			Type:                types.Gardener,
+			ProjectName:         "my-project",
+			CredentialsFilePath: "/path/to/kubeconfig",
+			CustomConfigurations: map[string]interface{}{
+				"target_provider": "gcp",
+				"worker_minimum":  "2",
+				"zones":           []string{"europe-west3-a", "europe-west3-b"},
+			},
+		}, provider)
+
+		_, provider, err = c.Hydroform("/new/kubeconfig")
+		require.NoError(t, err)
+		require.Equal(t, "/new/kubeconfig", provider.CredentialsFilePath)
+	})
+
+	t.Run("azure", func(t *testing.T) {
+		c := &Cluster{Name: "aks", Project: "rg", Provider: types.Azure, Vars: parseVars(azureVars)}
+
+		_, _, err := c.Hydroform("")
+		require.Error(t, err, "azure does not persist the credentials file path")
+
+		cluster, provider, err := c.Hydroform("/azure.toml")
+		require.NoError(t, err)
+		require.Equal(t, 2, cluster.NodeCount)
+		require.Equal(t, "Standard_D4_v3", cluster.MachineType)
+		require.Equal(t, 50, cluster.DiskSizeGB)
+		require.Empty(t, provider.CustomConfigurations)
+	})
+}
+
+func TestMetadata(t *testing.T) {
+	home, err := ioutil.TempDir("", "clusters")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	created := time.Date(2020, 4, 1, 12, 0, 0, 0, time.UTC)
+	writeCluster(t, home, types.GCP, "p", "with-ttl", "")
+	require.NoError(t, SaveMetadata(home, types.GCP, "p", "with-ttl", Metadata{Created: created, TTL: "24h"}))
+	writeCluster(t, home, types.GCP, "p", "without-metadata", "")
+
+	c, err := Find(home, "", "", "with-ttl")
+	require.NoError(t, err)
+	require.True(t, created.Equal(c.Created))
+	require.Equal(t, 24*time.Hour, c.TTL)
+	require.False(t, c.Overdue(created.Add(23*time.Hour)))
+	require.True(t, c.Overdue(created.Add(25*time.Hour)))
+
+	c, err = Find(home, types.GCP, "p", "without-metadata")
+	require.NoError(t, err)
+	require.False(t, c.Created.IsZero(), "creation time should fall back to the state files")
+	require.False(t, c.Overdue(time.Now().Add(1000*time.Hour)))
+}
+
+func TestDescribeTTL(t *testing.T) {
+	now := time.Date(2020, 4, 10, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "None", (&Cluster{}).DescribeTTL(now))
+	require.Equal(t, "72h0m0s", (&Cluster{Created: now, TTL: 72 * time.Hour}).DescribeTTL(now))
+	require.Equal(t, "1h0m0s (overdue since 30m0s)", (&Cluster{Created: now.Add(-90 * time.Minute), TTL: time.Hour}).DescribeTTL(now))
+}
+
+func TestNodePoolArgs(t *testing.T) {
+	gcp := &Cluster{Name: "gke", Project: "p", Provider: types.GCP, Vars: map[string]interface{}{"location": "europe-west3-a"}}
+	args, err := gcp.addNodePoolArgs(NodePool{Name: "gpu", NodeCount: 2, MachineType: "n1-highmem-8"}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"gcloud", "container", "node-pools", "create", "gpu", "--cluster", "gke", "--num-nodes", "2", "--quiet", "--machine-type", "n1-highmem-8", "--zone", "europe-west3-a", "--project", "p"}, args)
+
+	gcp.Vars["location"] = "europe-west3"
+	args, err = gcp.resizeArgs(6, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{"gcloud", "container", "clusters", "resize", "gke", "--node-pool", "default-pool", "--num-nodes", "2", "--quiet", "--region", "europe-west3", "--project", "p"}, args, "gcloud expects the nodes per zone of regional clusters")
+	_, err = gcp.resizeArgs(5, 3)
+	require.Error(t, err, "the nodes of a regional cluster cannot be spread evenly across its zones")
+	_, err = gcp.addNodePoolArgs(NodePool{Name: "gpu", NodeCount: 2}, 3)
+	require.Error(t, err, "the nodes of a regional node pool cannot be spread evenly across its zones")
+
+	azure := &Cluster{Name: "aks", Project: "rg", Provider: types.Azure, Vars: parseVars(azureVars)}
+	_, err = azure.addNodePoolArgs(NodePool{Name: "Invalid-Name", NodeCount: 1}, 1)
+	require.Error(t, err)
+	args, err = azure.removeNodePoolArgs("pool2")
+	require.NoError(t, err)
+	require.Equal(t, []string{"az", "aks", "nodepool", "delete", "--name", "pool2", "--cluster-name", "aks", "--resource-group", "rg"}, args)
+	_, err = azure.resizeArgs(3, 1)
+	require.Error(t, err, "azure clusters are resized with hydroform")
+
+	gardener := &Cluster{Provider: types.Gardener}
+	_, err = gardener.addNodePoolArgs(NodePool{Name: "pool", NodeCount: 1}, 1)
+	require.Error(t, err)
+}
+
+func TestRecordedChanges(t *testing.T) {
+	home, err := ioutil.TempDir("", "clusters")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	writeCluster(t, home, types.GCP, "p", "gke", "node_count = \"3\"\nresized_node_count = \"5\"\nnode_pools = [\"gpu:1:n1-highmem-8:0\",\"big:3::100\"]\ncredentials_file_path = \"/creds.json\"\n")
+	c, err := Find(home, types.GCP, "p", "gke")
+	require.NoError(t, err)
+	require.Equal(t, 5, c.Spec().NodeCount, "the node count of a resized cluster must replace the initial node count")
+	pools := []NodePool{{Name: "gpu", NodeCount: 1, MachineType: "n1-highmem-8"}, {Name: "big", NodeCount: 3, DiskSizeGB: 100}}
+	require.Equal(t, pools, c.NodePools())
+	require.Equal(t, c.Vars[nodePoolsVar], formatNodePools(pools))
+
+	cluster, provider, err := c.Hydroform("")
+	require.NoError(t, err)
+	require.Equal(t, 3, cluster.NodeCount, "hydroform must keep the initial node count, changing it recreates the cluster")
+	require.Equal(t, "5", provider.CustomConfigurations[resizedNodeCountVar], "the recorded changes must be applied with hydroform again")
+	require.Equal(t, c.Vars[nodePoolsVar], provider.CustomConfigurations[nodePoolsVar])
+
+	require.Error(t, c.RemoveNodePool(context.Background(), home, "", false, "unknown"))
+	require.Error(t, c.AddNodePool(context.Background(), home, "", false, NodePool{Name: "gpu", NodeCount: 1}))
+}
+
+func TestSaveEKS(t *testing.T) {
+	home, err := ioutil.TempDir("", "clusters")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	spec := &types.Cluster{Name: "eks", KubernetesVersion: "1.15", Location: "eu-central-1", MachineType: "m5.xlarge", NodeCount: 3, DiskSizeGB: 30}
+	require.NoError(t, SaveEKS(home, "", spec, NewMetadata(24*time.Hour)))
+
+	c, err := Find(home, types.AWS, "", "eks")
+	require.NoError(t, err)
+	require.Equal(t, "default", c.Project, "clusters without profile must be stored under the default profile")
+	require.Equal(t, "", c.AWSProfile())
+	require.Equal(t, spec, c.Spec())
+	require.Equal(t, 24*time.Hour, c.TTL)
+	require.True(t, c.HasState(), "EKS clusters are only persisted once provisioned")
+
+	require.NoError(t, SaveEKS(home, "dev", spec, NewMetadata(0)))
+	c, err = Find(home, types.AWS, "dev", "eks")
+	require.NoError(t, err)
+	require.Equal(t, "dev", c.AWSProfile())
+}
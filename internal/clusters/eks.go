@@ -0,0 +1,65 @@
+package clusters
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/pkg/errors"
+)
+
+// defaultAWSProfile is the project directory of AWS clusters provisioned without an explicit AWS profile
+const defaultAWSProfile = "default"
+
+// SaveEKS persists an AWS EKS cluster provisioned with eksctl. Hydroform does not support AWS, so the CLI writes the configuration
+// in the same format as hydroform does, with the AWS profile as the project. This way, EKS clusters are listed and deprovisioned like all other clusters.
+func SaveEKS(dataDir, profile string, cluster *types.Cluster, m Metadata) error {
+	project := profile
+	if project == "" {
+		project = defaultAWSProfile
+	}
+	vars := map[string]string{
+		"cluster_name":       cluster.Name,
+		"kubernetes_version": cluster.KubernetesVersion,
+		"location":           cluster.Location,
+		"machine_type":       cluster.MachineType,
+		"node_count":         strconv.Itoa(cluster.NodeCount),
+		"disk_size":          strconv.Itoa(cluster.DiskSizeGB),
+		"profile":            profile,
+	}
+
+	dir := Dir(dataDir, types.AWS, project, cluster.Name)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrap(err, "while saving the cluster configuration")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, varsFile), []byte(formatVars(vars)), 0600); err != nil {
+		return errors.Wrap(err, "while saving the cluster configuration")
+	}
+	return SaveMetadata(dataDir, types.AWS, project, cluster.Name, m)
+}
+
+// AWSProfile returns the AWS profile an EKS cluster was provisioned with, an empty profile means the default profile
+func (c *Cluster) AWSProfile() string {
+	profile, _ := c.Vars["profile"].(string)
+	return profile
+}
+
+// formatVars writes the variables in the format of the terraform variables file, sorted by key
+func formatVars(vars map[string]string) string {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf("%s = %q\n", k, vars[k]))
+	}
+	return b.String()
+}
@@ -0,0 +1,222 @@
+package clusters
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// File is a declarative definition of a cluster which the provision commands accept instead of or in addition to flags.
+// Every value of the file maps to a flag of the provision command of the provider, flags which are set explicitly take precedence.
+type File struct {
+	Provider          types.ProviderType `json:"provider"`
+	Name              string             `json:"name,omitempty"`
+	Project           string             `json:"project,omitempty"`
+	Credentials       string             `json:"credentials,omitempty"`
+	KubernetesVersion string             `json:"kubernetesVersion,omitempty"`
+	// Location is the zone or region of the cluster, for gardener it is the region
+	Location    string `json:"location,omitempty"`
+	MachineType string `json:"machineType,omitempty"`
+	DiskSizeGB  *int   `json:"diskSizeGB,omitempty"`
+	Nodes       *int   `json:"nodes,omitempty"`
+	TTL         string `json:"ttl,omitempty"`
+
+	Gardener *GardenerFile `json:"gardener,omitempty"`
+}
+
+// GardenerFile holds the settings of a cluster file which only gardener supports
+type GardenerFile struct {
+	TargetProvider string        `json:"targetProvider,omitempty"`
+	Secret         string        `json:"secret,omitempty"`
+	Zones          []string      `json:"zones,omitempty"`
+	DiskType       string        `json:"diskType,omitempty"`
+	CIDR           string        `json:"cidr,omitempty"`
+	WorkerCIDR     string        `json:"workerCIDR,omitempty"`
+	Scaler         *ScalerFile   `json:"scaler,omitempty"`
+	Network        *NetworkFile  `json:"network,omitempty"`
+	MachineImage   *MachineImage `json:"machineImage,omitempty"`
+}
+
+// ScalerFile holds the autoscaling settings of a gardener cluster
+type ScalerFile struct {
+	Min         *int `json:"min,omitempty"`
+	Max         *int `json:"max,omitempty"`
+	Surge       *int `json:"surge,omitempty"`
+	Unavailable *int `json:"unavailable,omitempty"`
+}
+
+// NetworkFile holds the network settings of a gardener cluster
+type NetworkFile struct {
+	Type     string `json:"type,omitempty"`
+	Nodes    string `json:"nodes,omitempty"`
+	Pods     string `json:"pods,omitempty"`
+	Services string `json:"services,omitempty"`
+}
+
+// MachineImage holds the machine image of the nodes of a gardener cluster
+type MachineImage struct {
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// Flags are the flags of a provision command a cluster file is applied to
+type Flags interface {
+	Changed(name string) bool
+	Set(name, value string) error
+}
+
+// LoadFile reads and validates the cluster file at the given path.
+// Unknown fields are rejected so that typos do not silently fall back to defaults.
+func LoadFile(path string) (*File, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading the cluster file %s", path)
+	}
+	f := &File{}
+	if err := yaml.UnmarshalStrict(data, f); err != nil {
+		return nil, errors.Wrapf(err, "while parsing the cluster file %s", path)
+	}
+	if err := f.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid cluster file %s", path)
+	}
+	return f, nil
+}
+
+// Validate checks the cluster file against the schema of its provider.
+// Checks which apply to flags as well, like CIDR formats, are left to the provision command of the provider.
+func (f *File) Validate() error {
+	var errs []string
+	switch f.Provider {
+	case types.GCP, types.Azure, types.Gardener:
+	case "":
+		errs = append(errs, "provider is required")
+	default:
+		errs = append(errs, fmt.Sprintf("provider %s is not supported, use one of gcp, azure or gardener", f.Provider))
+	}
+
+	if f.Gardener != nil && f.Provider != types.Gardener {
+		errs = append(errs, fmt.Sprintf("the gardener section is not supported by the provider %s", f.Provider))
+	}
+	if f.TTL != "" {
+		if _, err := time.ParseDuration(f.TTL); err != nil {
+			errs = append(errs, fmt.Sprintf("ttl %q is not a duration, for example 72h", f.TTL))
+		}
+	}
+	if f.Nodes != nil && *f.Nodes < 1 {
+		errs = append(errs, "nodes must be at least 1")
+	}
+	if f.DiskSizeGB != nil && *f.DiskSizeGB < 1 {
+		errs = append(errs, "diskSizeGB must be at least 1")
+	}
+	if f.Gardener != nil && f.Gardener.Scaler != nil {
+		if s := f.Gardener.Scaler; s.Min != nil && s.Max != nil && *s.Min > *s.Max {
+			errs = append(errs, fmt.Sprintf("gardener.scaler.min (%d) must not be greater than gardener.scaler.max (%d)", *s.Min, *s.Max))
+		}
+	}
+
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ApplyFile loads the cluster file at the given path and sets the flags of the provision command of the provider to its values.
+// Flags which are set explicitly are left untouched, so they override the file. Nothing happens if the path is empty.
+func ApplyFile(path string, provider types.ProviderType, flags Flags) error {
+	if path == "" {
+		return nil
+	}
+	f, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+	if f.Provider != provider {
+		return fmt.Errorf("the cluster file %s defines a %s cluster, it can not be provisioned on %s", path, f.Provider, provider)
+	}
+	return f.Apply(flags)
+}
+
+// Apply sets the flags to the values of the cluster file unless they are set explicitly
+func (f *File) Apply(flags Flags) error {
+	values := f.flagValues()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if flags.Changed(name) {
+			continue
+		}
+		if err := flags.Set(name, values[name]); err != nil {
+			return errors.Wrapf(err, "while applying the value of the flag %s from the cluster file", name)
+		}
+	}
+	return nil
+}
+
+// flagValues maps the values of the file to the flags of the provision commands
+func (f *File) flagValues() map[string]string {
+	values := map[string]string{}
+	set := func(flag, value string) {
+		if value != "" {
+			values[flag] = value
+		}
+	}
+	setInt := func(flag string, value *int) {
+		if value != nil {
+			values[flag] = strconv.Itoa(*value)
+		}
+	}
+
+	set("name", f.Name)
+	set("project", f.Project)
+	set("credentials", f.Credentials)
+	set("kube-version", f.KubernetesVersion)
+	if f.Provider == types.Gardener {
+		set("region", f.Location)
+	} else {
+		set("location", f.Location)
+	}
+	set("type", f.MachineType)
+	setInt("disk-size", f.DiskSizeGB)
+	setInt("nodes", f.Nodes)
+	set("ttl", f.TTL)
+
+	g := f.Gardener
+	if g == nil {
+		return values
+	}
+	set("target-provider", g.TargetProvider)
+	set("secret", g.Secret)
+	set("zones", strings.Join(g.Zones, ","))
+	set("disk-type", g.DiskType)
+	set("cidr", g.CIDR)
+	set("workercidr", g.WorkerCIDR)
+	if s := g.Scaler; s != nil {
+		setInt("scaler-min", s.Min)
+		setInt("scaler-max", s.Max)
+		setInt("surge", s.Surge)
+		setInt("unavailable", s.Unavailable)
+	}
+	if n := g.Network; n != nil {
+		set("network-type", n.Type)
+		set("network-nodes", n.Nodes)
+		set("network-pods", n.Pods)
+		set("network-services", n.Services)
+	}
+	if m := g.MachineImage; m != nil {
+		set("machine-image-name", m.Name)
+		set("machine-image-version", m.Version)
+	}
+	return values
+}
@@ -0,0 +1,107 @@
+package clusters
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/stretchr/testify/require"
+)
+
+const gardenerFile = `provider: gardener
+name: my-cluster
+project: my-project
+credentials: /path/to/kubeconfig
+location: europe-west3
+nodes: 4
+ttl: 72h
+gardener:
+  secret: my-secret
+  zones: [europe-west3-a, europe-west3-b]
+  scaler:
+    min: 2
+    max: 4
+  network:
+    pods: 100.96.0.0/11
+`
+
+type fakeFlags struct {
+	changed map[string]bool
+	values  map[string]string
+}
+
+func (f *fakeFlags) Changed(name string) bool {
+	return f.changed[name]
+}
+
+func (f *fakeFlags) Set(name, value string) error {
+	f.values[name] = value
+	return nil
+}
+
+func writeFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "cluster-file")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "cluster.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestApplyFile(t *testing.T) {
+	path := writeFile(t, gardenerFile)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	flags := &fakeFlags{changed: map[string]bool{"nodes": true}, values: map[string]string{}}
+	require.NoError(t, ApplyFile(path, types.Gardener, flags))
+	require.Equal(t, map[string]string{
+		"name":         "my-cluster",
+		"project":      "my-project",
+		"credentials":  "/path/to/kubeconfig",
+		"region":       "europe-west3",
+		"ttl":          "72h",
+		"secret":       "my-secret",
+		"zones":        "europe-west3-a,europe-west3-b",
+		"scaler-min":   "2",
+		"scaler-max":   "4",
+		"network-pods": "100.96.0.0/11",
+	}, flags.values, "explicitly set flags must not be overridden")
+
+	err := ApplyFile(path, types.GCP, flags)
+	require.Error(t, err, "the file must only be applied to the provider it defines")
+
+	require.NoError(t, ApplyFile("", types.GCP, flags), "no file should be a no-op")
+}
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{name: "valid", content: gardenerFile},
+		{name: "unknown field", content: "provider: gcp\nnode: 3\n", err: "unknown field"},
+		{name: "wrong type", content: "provider: gcp\nnodes: three\n", err: "while parsing"},
+		{name: "missing provider", content: "name: x\n", err: "provider is required"},
+		{name: "unsupported provider", content: "provider: minikube\n", err: "not supported"},
+		{name: "gardener section for gcp", content: "provider: gcp\ngardener:\n  secret: s\n", err: "gardener section"},
+		{name: "invalid ttl", content: "provider: azure\nttl: 3 days\n", err: "ttl"},
+		{name: "scaler min greater max", content: "provider: gardener\ngardener:\n  scaler:\n    min: 5\n    max: 3\n", err: "scaler.min"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeFile(t, tt.content)
+			defer os.RemoveAll(filepath.Dir(path))
+
+			_, err := LoadFile(path)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
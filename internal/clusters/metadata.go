@@ -0,0 +1,81 @@
+package clusters
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/pkg/errors"
+)
+
+// metadataFile holds the information about a cluster which hydroform does not persist itself
+const metadataFile = "kyma-cli.json"
+
+// Metadata is the information the CLI stores next to the hydroform state of a cluster
+type Metadata struct {
+	Created time.Time `json:"created"`
+	// TTL is the time to live of the cluster in the time.Duration format, for example 72h
+	TTL string `json:"ttl,omitempty"`
+}
+
+// SaveMetadata stores the metadata of the cluster next to its hydroform state
+func SaveMetadata(dataDir string, provider types.ProviderType, project, name string, m Metadata) error {
+	dir := Dir(dataDir, provider, project, name)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return errors.Wrap(err, "while saving the cluster metadata")
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "while saving the cluster metadata")
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, metadataFile), data, 0600); err != nil {
+		return errors.Wrap(err, "while saving the cluster metadata")
+	}
+	return nil
+}
+
+// loadMetadata reads the metadata of the cluster. Clusters provisioned without metadata get the time
+// of their last state change as creation time and no TTL.
+func (c *Cluster) loadMetadata() error {
+	data, err := ioutil.ReadFile(filepath.Join(c.Dir, metadataFile))
+	if os.IsNotExist(err) {
+		info, err := os.Stat(filepath.Join(c.Dir, stateFile))
+		if err != nil {
+			info, err = os.Stat(filepath.Join(c.Dir, varsFile))
+		}
+		if err == nil {
+			c.Created = info.ModTime()
+		}
+		c.metadata = Metadata{Created: c.Created}
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while reading the metadata of the cluster in %s", c.Dir)
+	}
+
+	m := Metadata{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return errors.Wrapf(err, "while reading the metadata of the cluster in %s", c.Dir)
+	}
+	c.metadata = m
+	c.Created = m.Created
+	if m.TTL != "" {
+		if c.TTL, err = time.ParseDuration(m.TTL); err != nil {
+			return errors.Wrapf(err, "while reading the TTL of the cluster in %s", c.Dir)
+		}
+	}
+	return nil
+}
+
+// NewMetadata returns the metadata of a cluster provisioned now with the given TTL, a TTL of 0 means no TTL
+func NewMetadata(ttl time.Duration) Metadata {
+	m := Metadata{Created: time.Now()}
+	if ttl > 0 {
+		m.TTL = ttl.String()
+	}
+	return m
+}
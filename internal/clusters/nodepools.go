@@ -0,0 +1,315 @@
+package clusters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	retry "github.com/avast/retry-go"
+	hf "github.com/kyma-incubator/hydroform/provision"
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// nodePoolsVar lists the node pools added to the cluster after provisioning. The hydroform modules only create the pool the cluster
+	// was provisioned with, so the pools are added with the CLI of the provider and recorded in the persisted configuration of the cluster.
+	nodePoolsVar = "node_pools"
+	// resizedNodeCountVar is the number of nodes of a GKE cluster resized after provisioning. The node_count variable of the hydroform GKE
+	// module is the initial node count of the cluster, changing it would recreate the cluster.
+	resizedNodeCountVar = "resized_node_count"
+)
+
+// NodePool is a pool of nodes added to a cluster after provisioning
+type NodePool struct {
+	Name        string
+	NodeCount   int
+	MachineType string
+	DiskSizeGB  int
+}
+
+var (
+	// GKE zones end with the zone letter, for example europe-west3-a, while regions do not
+	gcpZone = regexp.MustCompile(`-[a-z]$`)
+	// AKS node pool names are limited to 12 lowercase alphanumeric characters starting with a letter
+	azurePoolName = regexp.MustCompile(`^[a-z][a-z0-9]{0,11}$`)
+)
+
+// Apply changes the hydroform specification of the cluster and applies it with hydroform, which also persists the changed configuration and state.
+// The credentials file replaces the one the cluster was provisioned with, it is optional unless the path was not persisted.
+func (c *Cluster) Apply(dataDir, credentials string, verbose bool, change func(cluster *types.Cluster, provider *types.Provider) error) error {
+	cluster, provider, err := c.Hydroform(credentials)
+	if err != nil {
+		return err
+	}
+	if err := change(cluster, provider); err != nil {
+		return err
+	}
+
+	if !verbose {
+		// discard all the noise from terraform logs if not verbose
+		log.SetOutput(ioutil.Discard)
+	}
+	err = retry.Do(
+		func() error {
+			_, err := hf.Provision(cluster, provider, types.WithDataDir(dataDir), types.Persistent())
+			return err
+		},
+		retry.Attempts(3))
+	if err != nil {
+		return err
+	}
+
+	applied, err := load(c.Dir)
+	if err != nil {
+		return err
+	}
+	c.Vars = applied.Vars
+	return nil
+}
+
+// Resize changes the number of nodes of a GKE cluster with gcloud and records it in the hydroform configuration of the cluster.
+// Resizing with hydroform is not possible because its GKE module recreates the cluster if the node count changes.
+func (c *Cluster) Resize(ctx context.Context, dataDir, credentials string, verbose bool, nodes int) error {
+	if _, _, err := c.Hydroform(credentials); err != nil {
+		return err
+	}
+	zones, err := c.gcpZones(ctx, verbose)
+	if err != nil {
+		return err
+	}
+	args, err := c.resizeArgs(nodes, zones)
+	if err != nil {
+		return err
+	}
+	if _, err := runCLI(ctx, verbose, args...); err != nil {
+		return err
+	}
+	return c.recordChange(dataDir, credentials, verbose, func(p *types.Provider) {
+		p.CustomConfigurations[resizedNodeCountVar] = nodes
+	})
+}
+
+// AddNodePool adds the node pool to the cluster with the CLI of the provider and records it in the hydroform configuration of the cluster
+func (c *Cluster) AddNodePool(ctx context.Context, dataDir, credentials string, verbose bool, pool NodePool) error {
+	pools := c.NodePools()
+	for _, p := range pools {
+		if p.Name == pool.Name {
+			return fmt.Errorf("the node pool '%s' already exists in the cluster '%s'", pool.Name, c.Name)
+		}
+	}
+	if _, _, err := c.Hydroform(credentials); err != nil {
+		return err
+	}
+	zones := 1
+	if c.Provider == types.GCP {
+		var err error
+		if zones, err = c.gcpZones(ctx, verbose); err != nil {
+			return err
+		}
+	}
+	args, err := c.addNodePoolArgs(pool, zones)
+	if err != nil {
+		return err
+	}
+	if _, err := runCLI(ctx, verbose, args...); err != nil {
+		return err
+	}
+	return c.recordChange(dataDir, credentials, verbose, func(p *types.Provider) {
+		p.CustomConfigurations[nodePoolsVar] = formatNodePools(append(pools, pool))
+	})
+}
+
+// RemoveNodePool removes the node pool from the cluster with the CLI of the provider and from the hydroform configuration of the cluster
+func (c *Cluster) RemoveNodePool(ctx context.Context, dataDir, credentials string, verbose bool, name string) error {
+	var pools []NodePool
+	found := false
+	for _, p := range c.NodePools() {
+		if p.Name == name {
+			found = true
+			continue
+		}
+		pools = append(pools, p)
+	}
+	if !found {
+		return fmt.Errorf("the cluster '%s' has no node pool '%s' added with the Kyma CLI", c.Name, name)
+	}
+	if _, _, err := c.Hydroform(credentials); err != nil {
+		return err
+	}
+	args, err := c.removeNodePoolArgs(name)
+	if err != nil {
+		return err
+	}
+	if _, err := runCLI(ctx, verbose, args...); err != nil {
+		return err
+	}
+	return c.recordChange(dataDir, credentials, verbose, func(p *types.Provider) {
+		p.CustomConfigurations[nodePoolsVar] = formatNodePools(pools)
+	})
+}
+
+// recordChange applies the configuration of a cluster changed with the CLI of the provider, which refreshes the persisted state from the cluster
+func (c *Cluster) recordChange(dataDir, credentials string, verbose bool, record func(provider *types.Provider)) error {
+	err := c.Apply(dataDir, credentials, verbose, func(_ *types.Cluster, provider *types.Provider) error {
+		record(provider)
+		return nil
+	})
+	return errors.Wrapf(err, "the cluster '%s' was changed, but its state could not be updated, run the command again to update it", c.Name)
+}
+
+// NodePools returns the node pools added to the cluster after provisioning
+func (c *Cluster) NodePools() []NodePool {
+	entries, _ := c.Vars[nodePoolsVar].([]string)
+	var pools []NodePool
+	for _, e := range entries {
+		// the pools are persisted as name:nodes:machine type:disk size, as hydroform only persists strings and lists of strings
+		fields := strings.Split(e, ":")
+		if len(fields) != 4 {
+			continue
+		}
+		nodes, _ := strconv.Atoi(fields[1])
+		disk, _ := strconv.Atoi(fields[3])
+		pools = append(pools, NodePool{Name: fields[0], NodeCount: nodes, MachineType: fields[2], DiskSizeGB: disk})
+	}
+	return pools
+}
+
+func formatNodePools(pools []NodePool) []string {
+	entries := []string{}
+	for _, p := range pools {
+		entries = append(entries, fmt.Sprintf("%s:%d:%s:%d", p.Name, p.NodeCount, p.MachineType, p.DiskSizeGB))
+	}
+	return entries
+}
+
+// gcpZones returns the number of zones of the nodes of a GKE cluster. The node counts passed to gcloud apply to each zone of a regional cluster.
+func (c *Cluster) gcpZones(ctx context.Context, verbose bool) (int, error) {
+	if c.Provider != types.GCP || gcpZone.MatchString(c.Spec().Location) {
+		return 1, nil
+	}
+	args := append([]string{"gcloud", "container", "clusters", "describe", c.Name, "--format", "value(locations)"}, c.gcpLocationArgs()...)
+	out, err := runCLI(ctx, verbose, args...)
+	if err != nil {
+		return 0, err
+	}
+	locations := strings.TrimSpace(out)
+	if locations == "" {
+		return 0, fmt.Errorf("could not read the zones of the regional cluster '%s'", c.Name)
+	}
+	return len(strings.Split(locations, ";")), nil
+}
+
+// perZone returns the number of nodes per zone for the total number of nodes of a cluster in the given number of zones
+func perZone(nodes, zones int) (int, error) {
+	if nodes%zones != 0 {
+		return 0, fmt.Errorf("the nodes of a regional cluster are spread evenly across its %d zones, so the number of nodes must be a multiple of %d", zones, zones)
+	}
+	return nodes / zones, nil
+}
+
+func (c *Cluster) resizeArgs(nodes, zones int) ([]string, error) {
+	if c.Provider != types.GCP {
+		return nil, fmt.Errorf("%s clusters are resized with hydroform", c.Provider)
+	}
+	perZoneNodes, err := perZone(nodes, zones)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{"gcloud", "container", "clusters", "resize", c.Name, "--node-pool", "default-pool", "--num-nodes", strconv.Itoa(perZoneNodes), "--quiet"}
+	return append(args, c.gcpLocationArgs()...), nil
+}
+
+func (c *Cluster) addNodePoolArgs(pool NodePool, zones int) ([]string, error) {
+	if pool.NodeCount < 1 {
+		return nil, errors.New("a node pool needs at least 1 node")
+	}
+	var args []string
+	switch c.Provider {
+	case types.GCP:
+		perZoneNodes, err := perZone(pool.NodeCount, zones)
+		if err != nil {
+			return nil, err
+		}
+		args = []string{"gcloud", "container", "node-pools", "create", pool.Name, "--cluster", c.Name, "--num-nodes", strconv.Itoa(perZoneNodes), "--quiet"}
+		if pool.MachineType != "" {
+			args = append(args, "--machine-type", pool.MachineType)
+		}
+		if pool.DiskSizeGB > 0 {
+			args = append(args, "--disk-size", strconv.Itoa(pool.DiskSizeGB))
+		}
+		args = append(args, c.gcpLocationArgs()...)
+	case types.Azure:
+		if !azurePoolName.MatchString(pool.Name) {
+			return nil, fmt.Errorf("the node pool name '%s' is invalid, AKS allows up to 12 lowercase letters and digits starting with a letter", pool.Name)
+		}
+		args = []string{"az", "aks", "nodepool", "add", "--name", pool.Name, "--cluster-name", c.Name, "--resource-group", c.Project, "--node-count", strconv.Itoa(pool.NodeCount)}
+		if pool.MachineType != "" {
+			args = append(args, "--node-vm-size", pool.MachineType)
+		}
+		if pool.DiskSizeGB > 0 {
+			args = append(args, "--node-osdisk-size", strconv.Itoa(pool.DiskSizeGB))
+		}
+	default:
+		return nil, c.nodePoolsUnsupported()
+	}
+	return args, nil
+}
+
+func (c *Cluster) removeNodePoolArgs(name string) ([]string, error) {
+	switch c.Provider {
+	case types.GCP:
+		return append([]string{"gcloud", "container", "node-pools", "delete", name, "--cluster", c.Name, "--quiet"}, c.gcpLocationArgs()...), nil
+	case types.Azure:
+		return []string{"az", "aks", "nodepool", "delete", "--name", name, "--cluster-name", c.Name, "--resource-group", c.Project}, nil
+	default:
+		return nil, c.nodePoolsUnsupported()
+	}
+}
+
+func (c *Cluster) nodePoolsUnsupported() error {
+	if c.Provider == types.Gardener {
+		return errors.New("node pools are not supported for gardener clusters: the hydroform gardener module defines a single worker group, and additional workers would be removed by the next change of the cluster")
+	}
+	return fmt.Errorf("node pools are not supported for %s clusters", c.Provider)
+}
+
+// gcpLocationArgs returns the gcloud flags selecting the zone or region and the project of the cluster
+func (c *Cluster) gcpLocationArgs() []string {
+	location := c.Spec().Location
+	flag := "--region"
+	if gcpZone.MatchString(location) {
+		flag = "--zone"
+	}
+	return []string{flag, location, "--project", c.Project}
+}
+
+// runCLI runs the CLI of a provider, its first argument is the binary.
+// It only returns the standard output, so the logs the CLI writes to the standard error do not end up in parsed output.
+func runCLI(ctx context.Context, verbose bool, args ...string) (string, error) {
+	if _, err := exec.LookPath(args[0]); err != nil {
+		return "", fmt.Errorf("%s is not installed or not in your PATH", args[0])
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	var out, logs bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &logs
+
+	err := cmd.Run()
+	if verbose {
+		fmt.Printf("\nExecuted command:\n  %s\nwith output:\n  %s%s\n", strings.Join(args, " "), out.String(), logs.String())
+	}
+	if ctx.Err() != nil {
+		return out.String(), ctx.Err()
+	}
+	if err != nil {
+		return out.String(), fmt.Errorf("Executing the '%s' command with output '%s%s' and error message '%s' failed", strings.Join(args, " "), out.String(), logs.String(), err)
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,110 @@
+package diagnose
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Known failure signatures flagged in the diagnostics summary.
+const (
+	SignatureCrashLoopBackOff  = "CrashLoopBackOff"
+	SignatureImagePullBackOff  = "ImagePullBackOff"
+	SignaturePendingPVC        = "PendingPVC"
+	SignatureFailingWebhook    = "FailingWebhook"
+	SignatureInstallationError = "InstallationError"
+)
+
+// Finding describes a known failure signature detected in the cluster.
+type Finding struct {
+	// Signature is the name of the detected failure signature.
+	Signature string
+	// Object identifies the affected resource (e.g. "pod kyma-system/console-backend").
+	Object string
+	// Message contains details about the failure.
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("[%s] %s: %s", f.Signature, f.Object, f.Message)
+}
+
+// analyzePods flags containers stuck in a crash loop or unable to pull their image.
+func analyzePods(pods []corev1.Pod) []Finding {
+	var findings []Finding
+	for _, p := range pods {
+		statuses := append(append([]corev1.ContainerStatus{}, p.Status.InitContainerStatuses...), p.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if cs.State.Waiting == nil {
+				continue
+			}
+
+			var signature string
+			switch cs.State.Waiting.Reason {
+			case "CrashLoopBackOff":
+				signature = SignatureCrashLoopBackOff
+			case "ImagePullBackOff", "ErrImagePull":
+				signature = SignatureImagePullBackOff
+			default:
+				continue
+			}
+
+			msg := fmt.Sprintf("container '%s' is waiting with reason '%s' (restarts: %d)", cs.Name, cs.State.Waiting.Reason, cs.RestartCount)
+			if cs.State.Waiting.Message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, cs.State.Waiting.Message)
+			}
+			findings = append(findings, Finding{
+				Signature: signature,
+				Object:    fmt.Sprintf("pod %s/%s", p.Namespace, p.Name),
+				Message:   msg,
+			})
+		}
+	}
+	return findings
+}
+
+// analyzePVCs flags persistent volume claims which are not bound to a volume.
+func analyzePVCs(pvcs []corev1.PersistentVolumeClaim) []Finding {
+	var findings []Finding
+	for _, pvc := range pvcs {
+		if pvc.Status.Phase != corev1.ClaimPending {
+			continue
+		}
+		storageClass := "default"
+		if pvc.Spec.StorageClassName != nil {
+			storageClass = *pvc.Spec.StorageClassName
+		}
+		findings = append(findings, Finding{
+			Signature: SignaturePendingPVC,
+			Object:    fmt.Sprintf("pvc %s/%s", pvc.Namespace, pvc.Name),
+			Message:   fmt.Sprintf("claim is pending (storage class: %s)", storageClass),
+		})
+	}
+	return findings
+}
+
+// analyzeEvents flags warning events caused by admission webhooks which could not be called.
+// Repeated events for the same object are only reported once.
+func analyzeEvents(events []corev1.Event) []Finding {
+	var findings []Finding
+	seen := map[string]struct{}{}
+	for _, e := range events {
+		if e.Type != corev1.EventTypeWarning || !strings.Contains(e.Message, "failed calling") || !strings.Contains(e.Message, "webhook") {
+			continue
+		}
+
+		obj := fmt.Sprintf("%s %s/%s", strings.ToLower(e.InvolvedObject.Kind), e.InvolvedObject.Namespace, e.InvolvedObject.Name)
+		if _, ok := seen[obj]; ok {
+			continue
+		}
+		seen[obj] = struct{}{}
+
+		findings = append(findings, Finding{
+			Signature: SignatureFailingWebhook,
+			Object:    obj,
+			Message:   e.Message,
+		})
+	}
+	return findings
+}
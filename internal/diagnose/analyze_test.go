@@ -0,0 +1,83 @@
+package diagnose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAnalyzePods(t *testing.T) {
+	pods := []corev1.Pod{
+		fixPod("healthy", corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}),
+		fixPod("crashing", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}),
+		fixPod("no-image", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"}}),
+		fixPod("creating", corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}),
+	}
+
+	findings := analyzePods(pods)
+	require.Len(t, findings, 2, "Only crashing and not pullable containers must be flagged.")
+	require.Equal(t, SignatureCrashLoopBackOff, findings[0].Signature)
+	require.Equal(t, "pod ns/crashing", findings[0].Object)
+	require.Equal(t, SignatureImagePullBackOff, findings[1].Signature)
+	require.Equal(t, "pod ns/no-image", findings[1].Object)
+	require.Contains(t, findings[1].Message, "not found")
+}
+
+func TestAnalyzePVCs(t *testing.T) {
+	pvcs := []corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "bound", Namespace: "ns"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "ns"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+		},
+	}
+
+	findings := analyzePVCs(pvcs)
+	require.Len(t, findings, 1, "Only pending claims must be flagged.")
+	require.Equal(t, SignaturePendingPVC, findings[0].Signature)
+	require.Equal(t, "pvc ns/pending", findings[0].Object)
+}
+
+func TestAnalyzeEvents(t *testing.T) {
+	webhookMsg := `Internal error occurred: failed calling webhook "validation.istio.io": connection refused`
+	events := []corev1.Event{
+		fixEvent(corev1.EventTypeWarning, "my-deployment", webhookMsg),
+		fixEvent(corev1.EventTypeWarning, "my-deployment", webhookMsg),
+		fixEvent(corev1.EventTypeWarning, "other-deployment", "Back-off restarting failed container"),
+		fixEvent(corev1.EventTypeNormal, "another-deployment", webhookMsg),
+	}
+
+	findings := analyzeEvents(events)
+	require.Len(t, findings, 1, "Only warnings caused by webhooks must be flagged once per object.")
+	require.Equal(t, SignatureFailingWebhook, findings[0].Signature)
+	require.Equal(t, "replicaset ns/my-deployment", findings[0].Object)
+	require.Equal(t, webhookMsg, findings[0].Message)
+}
+
+func fixPod(name string, state corev1.ContainerState) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", State: state},
+			},
+		},
+	}
+}
+
+func fixEvent(eventType, object, msg string) corev1.Event {
+	return corev1.Event{
+		Type:    eventType,
+		Message: msg,
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "ReplicaSet",
+			Namespace: "ns",
+			Name:      object,
+		},
+	}
+}
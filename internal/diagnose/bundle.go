@@ -0,0 +1,69 @@
+// package diagnose provides the collection of diagnostic data from a Kyma cluster.
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const bundleRoot = "kyma-diagnostics"
+
+// Bundle writes diagnostic files into a gzip compressed tar archive.
+// All content added to the bundle is redacted before it is written.
+type Bundle struct {
+	gz      *gzip.Writer
+	tar     *tar.Writer
+	created time.Time
+}
+
+// NewBundle creates a new Bundle writing the archive to the given writer.
+func NewBundle(w io.Writer) *Bundle {
+	gz := gzip.NewWriter(w)
+	return &Bundle{
+		gz:      gz,
+		tar:     tar.NewWriter(gz),
+		created: time.Now(),
+	}
+}
+
+// Add writes the given content to the bundle under the given relative file name.
+func (b *Bundle) Add(name string, content []byte) error {
+	content = []byte(RedactText(string(content)))
+
+	hdr := &tar.Header{
+		Name:    path.Join(bundleRoot, name),
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: b.created,
+	}
+	if err := b.tar.WriteHeader(hdr); err != nil {
+		return errors.Wrapf(err, "while writing header of '%s' to the bundle", name)
+	}
+	if _, err := b.tar.Write(content); err != nil {
+		return errors.Wrapf(err, "while writing '%s' to the bundle", name)
+	}
+	return nil
+}
+
+// AddYAML marshals the given object to YAML and writes it to the bundle under the given relative file name.
+func (b *Bundle) AddYAML(name string, obj interface{}) error {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling '%s' to yaml", name)
+	}
+	return b.Add(name, content)
+}
+
+// Close flushes the bundle and closes the archive. It does not close the underlying writer.
+func (b *Bundle) Close() error {
+	if err := b.tar.Close(); err != nil {
+		return errors.Wrap(err, "while closing the bundle archive")
+	}
+	return b.gz.Close()
+}
@@ -0,0 +1,91 @@
+package diagnose
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestBundle(t *testing.T) {
+	buf := &bytes.Buffer{}
+	b := NewBundle(buf)
+
+	require.NoError(t, b.Add("logs/installer.log", []byte("starting installation with password=s3cr3t")))
+	require.NoError(t, b.AddYAML("cluster/info.yaml", map[string]string{"provider": "minikube"}))
+	require.NoError(t, b.Close())
+
+	files := readBundle(t, buf)
+	require.Len(t, files, 2, "Number of files in the bundle not as expected.")
+	require.Equal(t, "starting installation with password=<REDACTED>", files["kyma-diagnostics/logs/installer.log"], "Bundle content must be redacted.")
+	require.Equal(t, "provider: minikube\n", files["kyma-diagnostics/cluster/info.yaml"])
+}
+
+func TestRedactText(t *testing.T) {
+	cases := map[string]string{
+		"global.adminPassword: c2VjcmV0":            "global.adminPassword: <REDACTED>",
+		`{"token":"abc", "user": "admin"}`:          `{"token":<REDACTED>, "user": "admin"}`,
+		"level=info msg=installing api_key='12 34'": "level=info msg=installing api_key=<REDACTED>",
+		"nothing to see here":                       "nothing to see here",
+	}
+
+	for in, expected := range cases {
+		require.Equal(t, expected, RedactText(in), "Redacted text not as expected.")
+	}
+}
+
+func TestRedactSecretAndConfigMap(t *testing.T) {
+	s := corev1.Secret{Data: map[string][]byte{"global.tlsKey": []byte("key"), "ca.crt": []byte("crt")}}
+	rs := RedactSecret(s)
+	require.Nil(t, rs.Data, "Secret data must be removed.")
+	require.Equal(t, map[string]string{"global.tlsKey": redacted, "ca.crt": redacted}, rs.StringData)
+	require.Equal(t, []byte("key"), s.Data["global.tlsKey"], "Original Secret must not be modified.")
+
+	cm := corev1.ConfigMap{Data: map[string]string{"global.adminPassword": "pass", "global.domainName": "kyma.local"}}
+	rcm := RedactConfigMap(cm)
+	require.Equal(t, map[string]string{"global.adminPassword": redacted, "global.domainName": "kyma.local"}, rcm.Data)
+	require.Equal(t, "pass", cm.Data["global.adminPassword"], "Original ConfigMap must not be modified.")
+}
+
+func TestRedactPod(t *testing.T) {
+	p := corev1.Pod{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{{Env: []corev1.EnvVar{{Name: "DB_PASSWORD", Value: "init"}}}},
+		Containers: []corev1.Container{{Env: []corev1.EnvVar{
+			{Name: "ADMIN_PASSWORD", Value: "x"},
+			{Name: "API_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "token"}}},
+			{Name: "LOG_LEVEL", Value: "debug"},
+		}}},
+	}}
+
+	rp := RedactPod(p)
+	require.Equal(t, redacted, rp.Spec.InitContainers[0].Env[0].Value)
+	require.Equal(t, redacted, rp.Spec.Containers[0].Env[0].Value)
+	require.Equal(t, "", rp.Spec.Containers[0].Env[1].Value, "References to Secrets must be kept as they are.")
+	require.Equal(t, "debug", rp.Spec.Containers[0].Env[2].Value)
+	require.Equal(t, "x", p.Spec.Containers[0].Env[0].Value, "Original Pod must not be modified.")
+}
+
+func readBundle(t *testing.T, r io.Reader) map[string]string {
+	gz, err := gzip.NewReader(r)
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(content)
+	}
+	return files
+}
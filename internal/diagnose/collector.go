@@ -0,0 +1,241 @@
+package diagnose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	installerNamespace = "kyma-installer"
+	installerLabel     = "name=kyma-installer"
+	overridesLabel     = "installer=overrides"
+	installationName   = "kyma-installation"
+)
+
+var installationResource = schema.GroupVersionResource{
+	Group:    "installer.kyma-project.io",
+	Version:  "v1alpha1",
+	Resource: "installations",
+}
+
+// Collector gathers diagnostic data from a Kyma cluster.
+type Collector struct {
+	k8s         kube.KymaKube
+	kymaVersion func() (string, error)
+	podLogs     func(pod, container string) ([]byte, error)
+}
+
+// NewCollector creates a new Collector for the cluster accessible via the given client.
+// The given function provides the Kyma version of the cluster.
+func NewCollector(k8s kube.KymaKube, kymaVersion func() (string, error)) *Collector {
+	return &Collector{
+		k8s:         k8s,
+		kymaVersion: kymaVersion,
+		podLogs: func(pod, container string) ([]byte, error) {
+			return k8s.Static().CoreV1().Pods(installerNamespace).GetLogs(pod, &corev1.PodLogOptions{Container: container}).DoRaw()
+		},
+	}
+}
+
+// Collect gathers all diagnostics into the given bundle and returns a report summarizing them.
+// Diagnostics that cannot be collected do not stop the collection, they are listed in the report instead.
+// The report is added to the bundle as well. The collection stops as soon as the context is done.
+func (c *Collector) Collect(ctx context.Context, b *Bundle) (*Report, error) {
+	r := &Report{Created: time.Now()}
+
+	collectors := []struct {
+		name    string
+		collect func(*Bundle, *Report) error
+	}{
+		{"cluster info", c.collectClusterInfo},
+		{"Installation CR", c.collectInstallation},
+		{"installer logs", c.collectInstallerLogs},
+		{"installer overrides", c.collectOverrides},
+		{"pods", c.collectPods},
+		{"persistent volume claims", c.collectPVCs},
+		{"events", c.collectEvents},
+		{"test suites", func(b *Bundle, r *Report) error { return c.collectTestSuites(ctx, b) }},
+	}
+	for _, col := range collectors {
+		if ctx.Err() != nil {
+			return r, errors.Wrapf(ctx.Err(), "stopped collecting diagnostics before collecting %s", col.name)
+		}
+		if err := col.collect(b, r); err != nil {
+			r.Failures = append(r.Failures, fmt.Sprintf("%s: %s", col.name, err))
+		}
+	}
+
+	summary := &bytes.Buffer{}
+	if err := r.Write(summary); err != nil {
+		return r, errors.Wrap(err, "while writing the diagnostics summary")
+	}
+	if err := b.Add("summary.txt", summary.Bytes()); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+func (c *Collector) collectClusterInfo(b *Bundle, r *Report) error {
+	v, err := c.kymaVersion()
+	if err != nil {
+		return err
+	}
+	r.KymaVersion = v
+
+	cm, err := c.k8s.Static().CoreV1().ConfigMaps("kube-system").Get("kyma-cluster-info", metav1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return b.AddYAML("cluster/kyma-cluster-info.yaml", RedactConfigMap(*cm))
+}
+
+func (c *Collector) collectInstallation(b *Bundle, r *Report) error {
+	inst, err := c.k8s.Dynamic().Resource(installationResource).Namespace("default").Get(installationName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := b.AddYAML("installation/installation.yaml", inst.Object); err != nil {
+		return err
+	}
+
+	r.InstallationState, _, _ = unstructured.NestedString(inst.Object, "status", "state")
+	desc, _, _ := unstructured.NestedString(inst.Object, "status", "description")
+	if r.InstallationState == "Error" {
+		r.Findings = append(r.Findings, Finding{
+			Signature: SignatureInstallationError,
+			Object:    fmt.Sprintf("installation %s", installationName),
+			Message:   desc,
+		})
+	}
+
+	errorLog, _, _ := unstructured.NestedSlice(inst.Object, "status", "errorLog")
+	if len(errorLog) == 0 {
+		return nil
+	}
+	log := &strings.Builder{}
+	for _, entry := range errorLog {
+		e, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(log, "%v:\n %v [%v]\n", e["component"], e["log"], e["occurrences"])
+	}
+	return b.Add("installation/error-log.txt", []byte(log.String()))
+}
+
+// collectInstallerLogs adds the logs of every installer container. A container whose logs cannot be fetched,
+// for example because it is crash-looping, gets the error in place of its logs and does not stop the others.
+func (c *Collector) collectInstallerLogs(b *Bundle, r *Report) error {
+	pods, err := c.k8s.Static().CoreV1().Pods(installerNamespace).List(metav1.ListOptions{LabelSelector: installerLabel})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pods.Items {
+		for _, cnt := range p.Spec.Containers {
+			name := fmt.Sprintf("installation/logs/%s-%s.log", p.Name, cnt.Name)
+			logs, err := c.podLogs(p.Name, cnt.Name)
+			if err != nil {
+				err = errors.Wrapf(err, "while fetching logs for container %q of pod %q", cnt.Name, p.Name)
+				r.Failures = append(r.Failures, fmt.Sprintf("installer logs: %s", err))
+				name += ".error"
+				logs = []byte(err.Error())
+			}
+			if err := b.Add(name, logs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Collector) collectOverrides(b *Bundle, _ *Report) error {
+	cms, err := c.k8s.Static().CoreV1().ConfigMaps(installerNamespace).List(metav1.ListOptions{LabelSelector: overridesLabel})
+	if err != nil {
+		return err
+	}
+	for _, cm := range cms.Items {
+		if err := b.AddYAML(fmt.Sprintf("installation/overrides/configmap-%s.yaml", cm.Name), RedactConfigMap(cm)); err != nil {
+			return err
+		}
+	}
+
+	secrets, err := c.k8s.Static().CoreV1().Secrets(installerNamespace).List(metav1.ListOptions{LabelSelector: overridesLabel})
+	if err != nil {
+		return err
+	}
+	for _, s := range secrets.Items {
+		if err := b.AddYAML(fmt.Sprintf("installation/overrides/secret-%s.yaml", s.Name), RedactSecret(s)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Collector) collectPods(b *Bundle, r *Report) error {
+	pods, err := c.k8s.Static().CoreV1().Pods("").List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	r.Findings = append(r.Findings, analyzePods(pods.Items)...)
+
+	states := &strings.Builder{}
+	for _, p := range pods.Items {
+		var ready, restarts int
+		for _, cs := range p.Status.ContainerStatuses {
+			if cs.Ready {
+				ready++
+			}
+			restarts += int(cs.RestartCount)
+		}
+		fmt.Fprintf(states, "%s/%s\t%s\t%d/%d ready\t%d restarts\n", p.Namespace, p.Name, p.Status.Phase, ready, len(p.Spec.Containers), restarts)
+	}
+	if err := b.Add("cluster/pod-states.txt", []byte(states.String())); err != nil {
+		return err
+	}
+	redactedPods := pods.DeepCopy()
+	for i, p := range redactedPods.Items {
+		redactedPods.Items[i] = RedactPod(p)
+	}
+	return b.AddYAML("cluster/pods.yaml", redactedPods)
+}
+
+func (c *Collector) collectPVCs(b *Bundle, r *Report) error {
+	pvcs, err := c.k8s.Static().CoreV1().PersistentVolumeClaims("").List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	r.Findings = append(r.Findings, analyzePVCs(pvcs.Items)...)
+	return b.AddYAML("cluster/persistentvolumeclaims.yaml", pvcs)
+}
+
+func (c *Collector) collectEvents(b *Bundle, r *Report) error {
+	events, err := c.k8s.Static().CoreV1().Events("").List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	r.Findings = append(r.Findings, analyzeEvents(events.Items)...)
+	return b.AddYAML("cluster/events.yaml", events)
+}
+
+func (c *Collector) collectTestSuites(ctx context.Context, b *Bundle) error {
+	suites, err := c.k8s.Octopus().ListTestSuites(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	return b.AddYAML("tests/clustertestsuites.yaml", suites)
+}
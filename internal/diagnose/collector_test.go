@@ -0,0 +1,42 @@
+package diagnose
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli/internal/kube"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCollectInstallerLogs(t *testing.T) {
+	static := k8sfake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "kyma-installer", Namespace: installerNamespace, Labels: map[string]string{"name": "kyma-installer"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "installer"},
+			{Name: "sidecar"},
+		}},
+	})
+	c := NewCollector(kube.NewFromClients(static, nil, nil, nil), nil)
+	c.podLogs = func(pod, container string) ([]byte, error) {
+		if container == "installer" {
+			return nil, errors.New("container is crash-looping")
+		}
+		return []byte("sidecar started"), nil
+	}
+
+	buf := &bytes.Buffer{}
+	b := NewBundle(buf)
+	r := &Report{}
+	require.NoError(t, c.collectInstallerLogs(b, r))
+	require.NoError(t, b.Close())
+
+	files := readBundle(t, buf)
+	require.Len(t, files, 2, "A failing container must not hide the logs of the other containers.")
+	require.Contains(t, files["kyma-diagnostics/installation/logs/kyma-installer-installer.log.error"], "crash-looping")
+	require.Equal(t, "sidecar started", files["kyma-diagnostics/installation/logs/kyma-installer-sidecar.log"])
+	require.Len(t, r.Failures, 1)
+}
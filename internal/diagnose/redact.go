@@ -0,0 +1,68 @@
+package diagnose
+
+import (
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const redacted = "<REDACTED>"
+
+var (
+	// sensitiveKey matches names of keys which usually hold credentials.
+	sensitiveKey = regexp.MustCompile(`(?i)(password|passwd|secret|token|api[_-]?key|tls\.?key|private[_-]?key|credentials)`)
+	// sensitiveValue matches key-value pairs in YAML, JSON or log lines whose key usually holds credentials.
+	sensitiveValue = regexp.MustCompile(`(?i)([\w.-]*(?:password|passwd|secret|token|api[_-]?key|tls\.?key|private[_-]?key)[\w.-]*["']?\s*[:=]\s*)("[^"]*"|'[^']*'|[^\s,}]+)`)
+)
+
+// RedactText replaces the values of all key-value pairs in the given text whose key looks like it holds credentials.
+func RedactText(s string) string {
+	return sensitiveValue.ReplaceAllString(s, "${1}"+redacted)
+}
+
+// RedactSecret returns a copy of the given Secret with all of its values replaced.
+// The keys are kept as plain string data to show which data the Secret provides.
+func RedactSecret(s corev1.Secret) corev1.Secret {
+	r := *s.DeepCopy()
+	r.StringData = make(map[string]string, len(s.Data)+len(s.StringData))
+	for k := range s.Data {
+		r.StringData[k] = redacted
+	}
+	for k := range s.StringData {
+		r.StringData[k] = redacted
+	}
+	r.Data = nil
+	return r
+}
+
+// RedactConfigMap returns a copy of the given ConfigMap with the values of all keys that look like they hold credentials replaced.
+func RedactConfigMap(cm corev1.ConfigMap) corev1.ConfigMap {
+	r := *cm.DeepCopy()
+	for k := range r.Data {
+		if sensitiveKey.MatchString(k) {
+			r.Data[k] = redacted
+		}
+	}
+	for k := range r.BinaryData {
+		if sensitiveKey.MatchString(k) {
+			r.BinaryData[k] = []byte(redacted)
+		}
+	}
+	return r
+}
+
+// RedactPod returns a copy of the given Pod with the values of all environment variables whose name looks like it holds credentials replaced.
+// Environment variables are stored as separate name and value entries, which RedactText can't relate to each other.
+func RedactPod(p corev1.Pod) corev1.Pod {
+	r := *p.DeepCopy()
+	for _, containers := range [][]corev1.Container{r.Spec.InitContainers, r.Spec.Containers} {
+		for i := range containers {
+			for j, env := range containers[i].Env {
+				if env.Value != "" && sensitiveKey.MatchString(env.Name) {
+					containers[i].Env[j].Value = redacted
+				}
+			}
+		}
+	}
+	return r
+}
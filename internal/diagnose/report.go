@@ -0,0 +1,68 @@
+package diagnose
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Report summarizes the outcome of a diagnostics collection.
+type Report struct {
+	// Created is the time when the collection started.
+	Created time.Time
+	// KymaVersion is the Kyma version installed in the cluster.
+	KymaVersion string
+	// InstallationState is the state of the Kyma Installation CR.
+	InstallationState string
+	// Findings lists all known failure signatures detected in the cluster.
+	Findings []Finding
+	// Failures lists the diagnostics that could not be collected.
+	Failures []string
+}
+
+// Write prints the report in a human readable format to the given writer.
+func (r *Report) Write(out io.Writer) error {
+	w := &errWriter{w: out}
+
+	w.printf("Kyma diagnostics summary (%s)\n\n", r.Created.Format(time.RFC3339))
+	w.printf("Kyma version:\t\t%s\n", valueOrNA(r.KymaVersion))
+	w.printf("Installation state:\t%s\n\n", valueOrNA(r.InstallationState))
+
+	if len(r.Findings) == 0 {
+		w.printf("No known failure signatures detected.\n")
+	} else {
+		w.printf("Detected failure signatures (%d):\n", len(r.Findings))
+		for _, f := range r.Findings {
+			w.printf("  %s\n", f)
+		}
+	}
+
+	if len(r.Failures) > 0 {
+		w.printf("\nThe following diagnostics could not be collected:\n")
+		for _, f := range r.Failures {
+			w.printf("  %s\n", f)
+		}
+	}
+
+	return w.err
+}
+
+func valueOrNA(v string) string {
+	if v == "" {
+		return "N/A"
+	}
+	return v
+}
+
+// errWriter remembers the first error that occurred while printing so that it only needs to be checked once.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
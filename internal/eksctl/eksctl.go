@@ -0,0 +1,97 @@
+// Package eksctl runs the eksctl CLI, which manages AWS EKS clusters. The AWS credentials are read by eksctl from the standard AWS profile files.
+package eksctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// binary is the eksctl executable, it is a variable so that tests can replace it
+var binary = "eksctl"
+
+//CheckInstalled checks whether the eksctl binary is available
+func CheckInstalled() error {
+	if _, err := exec.LookPath(binary); err != nil {
+		return errors.New("eksctl is not installed or not in your PATH, install it following https://eksctl.io/introduction/#installation")
+	}
+	return nil
+}
+
+//RunCmd executes an eksctl command with the given arguments until it finishes or the context is done and returns its standard output.
+//The log messages eksctl writes to the standard error are only part of the error or of the verbose output, so that the output can be parsed.
+//The profile selects the AWS profile to use, an empty profile means the default profile.
+func RunCmd(ctx context.Context, verbose bool, profile string, rawArgs ...string) (string, error) {
+	args := append([]string{}, rawArgs...)
+	if profile != "" {
+		args = append(args, "--profile", profile)
+	}
+
+	cmd := exec.CommandContext(ctx, binary, args...)
+	var out, logs bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &logs
+
+	err := cmd.Run()
+	if ctx.Err() != nil {
+		return out.String(), ctx.Err()
+	}
+	if err != nil {
+		if verbose {
+			fmt.Printf("\nExecuted command:\n  eksctl %s\nwith output:\n  %s%s\nand error:\n  %s\n", strings.Join(args, " "), out.String(), logs.String(), err)
+		}
+		return out.String(), fmt.Errorf("Executing the 'eksctl %s' command with output '%s%s' and error message '%s' failed", strings.Join(args, " "), out.String(), logs.String(), err)
+	}
+	if verbose {
+		fmt.Printf("\nExecuted command:\n  eksctl %s\nwith output:\n  %s%s\n", strings.Join(args, " "), out.String(), logs.String())
+	}
+	return out.String(), nil
+}
+
+//Kubeconfig lets eksctl write the kubeconfig of the cluster into a temporary file and returns its content
+func Kubeconfig(ctx context.Context, verbose bool, profile, name, region string) ([]byte, error) {
+	tmpFile, err := ioutil.TempFile(os.TempDir(), "kyma-eks-*.yaml")
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot create temporary file for the kubeconfig")
+	}
+	defer os.Remove(tmpFile.Name())
+	if err := tmpFile.Close(); err != nil {
+		return nil, err
+	}
+
+	if _, err := RunCmd(ctx, verbose, profile, "utils", "write-kubeconfig",
+		"--cluster", name, "--region", region, "--kubeconfig", tmpFile.Name()); err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(tmpFile.Name())
+}
+
+//ClusterStatus returns the status EKS reports for the cluster, for example ACTIVE or DELETING
+func ClusterStatus(ctx context.Context, verbose bool, profile, name, region string) (string, error) {
+	out, err := RunCmd(ctx, verbose, profile, "get", "cluster", "--name", name, "--region", region, "--output", "json")
+	if err != nil {
+		return "", err
+	}
+	return parseStatus(out)
+}
+
+// parseStatus reads the status from the JSON output of "eksctl get cluster", which is a list with the cluster as the only entry
+func parseStatus(out string) (string, error) {
+	var clusters []struct {
+		Status string `json:"Status"`
+	}
+	if err := json.Unmarshal([]byte(out), &clusters); err != nil {
+		return "", errors.Wrap(err, "while reading the cluster status from eksctl")
+	}
+	if len(clusters) != 1 {
+		return "", fmt.Errorf("eksctl returned %d clusters instead of one", len(clusters))
+	}
+	return clusters[0].Status, nil
+}
@@ -0,0 +1,45 @@
+package eksctl
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatus(t *testing.T) {
+	status, err := parseStatus(`[{"Name": "my-cluster", "Version": "1.15", "Status": "ACTIVE"}]`)
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", status)
+
+	_, err = parseStatus(`[]`)
+	require.Error(t, err, "a missing cluster must fail")
+
+	_, err = parseStatus(`Error: no output`)
+	require.Error(t, err, "output which is not JSON must fail")
+}
+
+func TestClusterStatusIgnoresLogs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake eksctl is a shell script")
+	}
+
+	dir, err := ioutil.TempDir("", "eksctl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// eksctl writes its log messages to the standard error, next to the JSON on the standard output
+	fake := filepath.Join(dir, "eksctl")
+	script := "#!/bin/sh\necho '[ℹ]  eksctl version 0.16.0' >&2\necho '[{\"Name\": \"my-cluster\", \"Status\": \"ACTIVE\"}]'\n"
+	require.NoError(t, ioutil.WriteFile(fake, []byte(script), 0755))
+	defer func(b string) { binary = b }(binary)
+	binary = fake
+
+	status, err := ClusterStatus(context.Background(), false, "dev", "my-cluster", "eu-central-1")
+	require.NoError(t, err)
+	require.Equal(t, "ACTIVE", status)
+}
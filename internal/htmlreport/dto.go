@@ -0,0 +1,41 @@
+package htmlreport
+
+type report struct {
+	Name           string
+	Condition      string
+	StartTime      string
+	CompletionTime string
+	Duration       string
+	Concurrency    int64
+	MaxRetries     int64
+	Count          int64
+	Succeeded      int
+	Failed         int
+	Skipped        int
+	Other          int
+	CLIVersion     string
+	Tests          []test
+}
+
+type test struct {
+	// Anchor identifies the test in the document, as test names are only unique within their namespace.
+	// Namespaces and names can't contain underscores, so joining them with one keeps the anchor unique.
+	Anchor     string
+	Name       string
+	Namespace  string
+	Status     string
+	Retries    int
+	Executions []execution
+	Logs       string
+}
+
+type execution struct {
+	ID       string
+	PodPhase string
+	Duration string
+	Reason   string
+	Message  string
+	// Offset and Width position the execution on the timeline in percent
+	Offset float64
+	Width  float64
+}
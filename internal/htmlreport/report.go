@@ -0,0 +1,193 @@
+package htmlreport
+
+import (
+	"io"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/cmd/kyma/version"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+//go:generate mockery -name=logsFetcher -output=automock -outpkg=automock -case=underscore
+
+// logsFetcher allows you to fetch logs from the testing pods
+type logsFetcher interface {
+	Logs(result oct.TestResult) (string, error)
+}
+
+// Creator provides functionality for creating a self-contained HTML report for given test suite
+type Creator struct {
+	logsFetcher logsFetcher
+}
+
+// NewCreator returns new instance of the Creator
+func NewCreator(logsFetcher logsFetcher) *Creator {
+	return &Creator{logsFetcher: logsFetcher}
+}
+
+// Write creates an HTML document for suite and writes it to out.
+func (c *Creator) Write(out io.Writer, suite *oct.ClusterTestSuite) error {
+	if err := reportTemplate.Execute(out, c.generateReport(suite)); err != nil {
+		return errors.Wrap(err, "while writing HTML report")
+	}
+
+	return nil
+}
+
+func (c *Creator) generateReport(suite *oct.ClusterTestSuite) report {
+	r := report{
+		Name:        suite.Name,
+		Condition:   condition(suite),
+		Concurrency: suite.Spec.Concurrency,
+		MaxRetries:  suite.Spec.MaxRetries,
+		Count:       suite.Spec.Count,
+		CLIVersion:  c.cliVersion(),
+	}
+
+	start, end := timelineBounds(suite)
+	if suite.Status.StartTime != nil {
+		r.StartTime = formatTime(suite.Status.StartTime.Time)
+	}
+	// CompletionTime is not set when test suite is timed out or still running
+	if suite.Status.CompletionTime != nil {
+		r.CompletionTime = formatTime(suite.Status.CompletionTime.Time)
+	}
+	if suite.Status.StartTime != nil && suite.Status.CompletionTime != nil {
+		r.Duration = suite.Status.CompletionTime.Sub(suite.Status.StartTime.Time).String()
+	}
+
+	for _, res := range suite.Status.Results {
+		t := test{
+			Anchor:    res.Namespace + "_" + res.Name,
+			Name:      res.Name,
+			Namespace: res.Namespace,
+			Status:    string(res.Status),
+			Retries:   retries(res.Executions, suite.Spec.MaxRetries),
+		}
+
+		for _, e := range res.Executions {
+			exec := execution{
+				ID:       e.ID,
+				PodPhase: string(e.PodPhase),
+				Reason:   e.Reason,
+				Message:  e.Message,
+			}
+			if e.StartTime != nil {
+				execEnd := end
+				if e.CompletionTime != nil {
+					execEnd = e.CompletionTime.Time
+					exec.Duration = e.CompletionTime.Sub(e.StartTime.Time).String()
+				}
+				exec.Offset, exec.Width = position(start, end, e.StartTime.Time, execEnd)
+			}
+			t.Executions = append(t.Executions, exec)
+		}
+
+		if len(res.Executions) > 0 {
+			logs, err := c.logsFetcher.Logs(res)
+			if err != nil {
+				logs = "Cannot fetch logs, got error: " + err.Error()
+			}
+			t.Logs = logs
+		}
+
+		switch res.Status {
+		case oct.TestSucceeded:
+			r.Succeeded++
+		case oct.TestFailed:
+			r.Failed++
+		case oct.TestSkipped:
+			r.Skipped++
+		default:
+			r.Other++
+		}
+		r.Tests = append(r.Tests, t)
+	}
+
+	return r
+}
+
+func (c *Creator) cliVersion() string {
+	if version.Version == "" {
+		return "N/A"
+	}
+	return version.Version
+}
+
+// retries counts the executions which repeated a failed execution, executions repeated because of the count of the suite are no retries
+func retries(executions []oct.TestExecution, maxRetries int64) int {
+	if maxRetries == 0 {
+		return 0
+	}
+	n := 0
+	for i := 1; i < len(executions); i++ {
+		if executions[i-1].PodPhase == corev1.PodFailed {
+			n++
+		}
+	}
+	if int64(n) > maxRetries {
+		return int(maxRetries)
+	}
+	return n
+}
+
+func condition(suite *oct.ClusterTestSuite) string {
+	for _, cond := range suite.Status.Conditions {
+		if cond.Status == oct.StatusTrue {
+			return string(cond.Type)
+		}
+	}
+	return "Unknown"
+}
+
+// timelineBounds returns the time range covered by the suite and all its executions
+func timelineBounds(suite *oct.ClusterTestSuite) (time.Time, time.Time) {
+	var start, end time.Time
+	extend := func(t time.Time) {
+		if start.IsZero() || t.Before(start) {
+			start = t
+		}
+		if t.After(end) {
+			end = t
+		}
+	}
+
+	if suite.Status.StartTime != nil {
+		extend(suite.Status.StartTime.Time)
+	}
+	if suite.Status.CompletionTime != nil {
+		extend(suite.Status.CompletionTime.Time)
+	}
+	for _, r := range suite.Status.Results {
+		for _, e := range r.Executions {
+			if e.StartTime != nil {
+				extend(e.StartTime.Time)
+			}
+			if e.CompletionTime != nil {
+				extend(e.CompletionTime.Time)
+			}
+		}
+	}
+	return start, end
+}
+
+// position returns the offset and width of an execution on the timeline in percent
+func position(start, end, from, to time.Time) (float64, float64) {
+	total := end.Sub(start)
+	if total <= 0 {
+		return 0, 100
+	}
+	offset := float64(from.Sub(start)) / float64(total) * 100
+	width := float64(to.Sub(from)) / float64(total) * 100
+	// keep very short executions visible
+	if width < 0.5 {
+		width = 0.5
+	}
+	return offset, width
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
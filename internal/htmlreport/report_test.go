@@ -0,0 +1,83 @@
+package htmlreport_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/internal/htmlreport"
+	"github.com/kyma-project/cli/internal/htmlreport/automock"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/golden"
+	"sigs.k8s.io/yaml"
+)
+
+// TestWriteHTMLReport tests that proper HTML document is written to given output.
+//
+// This test is based on golden file.
+// If the `-test.update-golden` flag is set then the actual content is written
+// to the golden file.
+//
+// Example:
+//   go test ./internal/htmlreport/... -v -test.update-golden
+func TestWriteHTMLReport(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Test Suite Failed",
+		},
+		{
+			name: "TestSuite is still running but timeout occur",
+		},
+		{
+			name: "TestSuite completed without start time",
+		},
+		{
+			name: "TestSuite with repeated executions",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			fixCTS := getCTSFromTestData(t)
+
+			mockedLogsFetcher := &automock.LogsFetcher{}
+			defer mockedLogsFetcher.AssertExpectations(t)
+
+			for _, result := range fixCTS.Status.Results {
+				// expecting get logs call for each executed test
+				if len(result.Executions) > 0 {
+					mockedLogsFetcher.On("Logs", result).
+						Return(fmt.Sprintf("Faked logs for execution: %s <script>", result.Name), nil).Once()
+				}
+			}
+
+			creator := htmlreport.NewCreator(mockedLogsFetcher)
+
+			gotOutput := new(bytes.Buffer)
+
+			// when
+			err := creator.Write(gotOutput, &fixCTS)
+
+			// then
+			require.NoError(t, err)
+			golden.Assert(t, gotOutput.String(), t.Name()+".golden.html")
+		})
+	}
+}
+
+func getCTSFromTestData(t *testing.T) oct.ClusterTestSuite {
+	raw, err := ioutil.ReadFile(path.Join("testdata", t.Name()+".input.yaml"))
+	require.NoError(t, err)
+
+	cts := oct.ClusterTestSuite{}
+	err = yaml.Unmarshal(raw, &cts)
+	require.NoError(t, err)
+
+	return cts
+}
+
@@ -0,0 +1,82 @@
+package htmlreport
+
+import "html/template"
+
+var reportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Test suite {{.Name}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #32363a; }
+h1 { font-size: 1.6em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 2em; }
+th, td { text-align: left; padding: 6px 10px; border-bottom: 1px solid #e5e5e5; vertical-align: top; }
+.summary td:first-child { font-weight: bold; width: 12em; }
+.status { font-weight: bold; }
+.Succeeded { color: #107e3e; }
+.Failed, .Error { color: #bb0000; }
+.Skipped { color: #6a6d70; }
+.Running, .Scheduled, .NotYetScheduled, .Unknown { color: #e9730c; }
+.timeline { position: relative; height: 14px; background: #f5f5f5; min-width: 300px; }
+.bar { position: absolute; top: 0; height: 14px; background: #107e3e; }
+.bar.Failed { background: #bb0000; }
+.bar.Running, .bar.Pending, .bar.Unknown { background: #e9730c; }
+pre { background: #f5f5f5; padding: 1em; overflow-x: auto; max-height: 40em; }
+footer { color: #6a6d70; font-size: 0.8em; }
+</style>
+</head>
+<body>
+<h1>Test suite {{.Name}} <span class="status {{.Condition}}">{{.Condition}}</span></h1>
+<table class="summary">
+<tr><td>Start time</td><td>{{if .StartTime}}{{.StartTime}}{{else}}Not started yet{{end}}</td></tr>
+<tr><td>Completion time</td><td>{{if .CompletionTime}}{{.CompletionTime}}{{else}}Not finished yet{{end}}</td></tr>
+{{- if .Duration}}
+<tr><td>Duration</td><td>{{.Duration}}</td></tr>
+{{- end}}
+<tr><td>Tests</td><td>{{len .Tests}} ({{.Succeeded}} succeeded, {{.Failed}} failed, {{.Skipped}} skipped, {{.Other}} other)</td></tr>
+<tr><td>Concurrency</td><td>{{.Concurrency}}</td></tr>
+<tr><td>Max retries</td><td>{{.MaxRetries}}</td></tr>
+<tr><td>Count</td><td>{{.Count}}</td></tr>
+</table>
+
+<h2>Timeline</h2>
+<table>
+<tr><th>Test</th><th>Status</th><th>Retries</th><th>Executions</th></tr>
+{{- range .Tests}}
+<tr>
+<td><a href="#{{.Anchor}}">{{.Namespace}}/{{.Name}}</a></td>
+<td class="status {{.Status}}">{{.Status}}</td>
+<td>{{.Retries}}</td>
+<td><div class="timeline">
+{{- range .Executions}}
+<div class="bar {{.PodPhase}}" style="left: {{printf "%.2f" .Offset}}%; width: {{printf "%.2f" .Width}}%" title="{{.ID}}: {{.PodPhase}}{{if .Duration}} in {{.Duration}}{{end}}"></div>
+{{- end}}
+</div></td>
+</tr>
+{{- end}}
+</table>
+
+<h2>Executions</h2>
+{{- range .Tests}}
+<h3 id="{{.Anchor}}">{{.Namespace}}/{{.Name}} <span class="status {{.Status}}">{{.Status}}</span></h3>
+{{- if .Executions}}
+<table>
+<tr><th>Pod</th><th>Phase</th><th>Duration</th><th>Message</th></tr>
+{{- range .Executions}}
+<tr><td>{{.ID}}</td><td class="status {{.PodPhase}}">{{.PodPhase}}</td><td>{{.Duration}}</td><td>{{.Reason}} {{.Message}}</td></tr>
+{{- end}}
+</table>
+<details>
+<summary>Container logs</summary>
+<pre>{{.Logs}}</pre>
+</details>
+{{- else}}
+<p>The test was not executed.</p>
+{{- end}}
+{{- end}}
+
+<footer>Generated by Kyma CLI {{.CLIVersion}}</footer>
+</body>
+</html>
+`))
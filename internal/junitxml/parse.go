@@ -0,0 +1,17 @@
+package junitxml
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Parse reads a JUnit XML document, such as one written by the Creator.
+func Parse(in io.Reader) (JUnitTestSuites, error) {
+	suites := JUnitTestSuites{}
+	if err := xml.NewDecoder(in).Decode(&suites); err != nil {
+		return JUnitTestSuites{}, errors.Wrap(err, "while parsing JUnit XML")
+	}
+	return suites, nil
+}
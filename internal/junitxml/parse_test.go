@@ -0,0 +1,24 @@
+package junitxml_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kyma-project/cli/internal/junitxml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open(path.Join("testdata", "TestWriteJUnitXMLReport", "Test_Suite_Failed.golden.xml"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	suites, err := junitxml.Parse(f)
+
+	require.NoError(t, err)
+	require.Len(t, suites.Suites, 1)
+	require.Equal(t, "testing", suites.Suites[0].Name)
+	require.Len(t, suites.Suites[0].TestCases, 16)
+	require.Equal(t, 3, suites.Suites[0].Failures)
+}
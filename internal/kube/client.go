@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/kyma-project/cli/pkg/api/octopus"
-	corev1 "k8s.io/api/core/v1"
 
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -18,7 +17,6 @@ import (
 
 const (
 	defaultHTTPTimeout = 30 * time.Second
-	defaultWaitSleep   = 3 * time.Second
 )
 
 // client is the default KymaKube implementation
@@ -76,6 +74,17 @@ func NewFromConfigWithTimeout(url, file string, t time.Duration) (KymaKube, erro
 
 }
 
+// NewFromClients creates a new Kubernetes client based on the given clients, for example on fake clients in tests.
+// Clients which are not needed can be nil.
+func NewFromClients(static kubernetes.Interface, dynamic dynamic.Interface, octps octopus.Interface, istio istioNet.Interface) KymaKube {
+	return &client{
+		static:  static,
+		dynamic: dynamic,
+		octps:   octps,
+		istio:   istio,
+	}
+}
+
 func (c *client) Static() kubernetes.Interface {
 	return c.static
 }
@@ -116,54 +125,3 @@ func (c *client) IsPodDeployedByLabel(namespace, labelName, labelValue string) (
 
 	return len(pods.Items) > 0, nil
 }
-
-func (c *client) WaitPodStatus(namespace, name string, status corev1.PodPhase) error {
-	for {
-		pod, err := c.Static().CoreV1().Pods(namespace).Get(name, metav1.GetOptions{})
-		if err != nil && !strings.Contains(err.Error(), "not found") {
-			return err
-		}
-
-		if status == pod.Status.Phase {
-			return nil
-		}
-		time.Sleep(defaultWaitSleep)
-	}
-}
-
-func (c *client) WaitPodStatusByLabel(namespace, labelName, labelValue string, status corev1.PodPhase) error {
-	for {
-		pods, err := c.Static().CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue)})
-		if err != nil {
-			return err
-		}
-
-		ok := true
-		for _, pod := range pods.Items {
-			// if any pod is not in the desired status no need to check further
-			if status != pod.Status.Phase {
-				ok = false
-				break
-			}
-		}
-		if ok {
-			return nil
-		}
-		time.Sleep(defaultWaitSleep)
-	}
-}
-
-// TODO we do not need more wait functions once deleteion is not done via Kubectl, the K8s API will wait on its own
-func (c *client) WaitPodsGone(namespace, labelName, labelValue string) error {
-	for {
-		deployed, err := c.IsPodDeployedByLabel(namespace, labelName, labelValue)
-		if err != nil {
-			return err
-		}
-
-		if !deployed {
-			return nil
-		}
-		time.Sleep(defaultWaitSleep)
-	}
-}
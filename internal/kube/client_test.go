@@ -1,10 +1,12 @@
 package kube
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
+	pkgErrors "github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -98,7 +100,7 @@ func TestWaitPodStatus(t *testing.T) {
 	// wait for the pod to be running in a separate goroutine
 	waitCh := make(chan error)
 	go func(ch chan<- error) {
-		ch <- c.WaitPodStatus("ns", "test-pod1", corev1.PodRunning)
+		ch <- c.WaitPodStatus(context.Background(), "ns", "test-pod1", corev1.PodRunning)
 		close(ch)
 	}(waitCh)
 
@@ -130,7 +132,7 @@ func TestWaitPodStatusByLabel(t *testing.T) {
 	// wait for the pod to be running in a separate goroutine
 	waitCh := make(chan error)
 	go func(ch chan<- error) {
-		ch <- c.WaitPodStatusByLabel("ns", "team", "huskies", corev1.PodRunning)
+		ch <- c.WaitPodStatusByLabel(context.Background(), "ns", "team", "huskies", corev1.PodRunning)
 		close(ch)
 	}(waitCh)
 
@@ -144,6 +146,26 @@ func TestWaitPodStatusByLabel(t *testing.T) {
 	require.NoError(t, <-waitCh)
 }
 
+func TestWaitPodStatusCancelled(t *testing.T) {
+	// setup
+	c := fakeClientWithNS(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// wait for a pod which never shows up in a separate goroutine
+	waitCh := make(chan error)
+	go func(ch chan<- error) {
+		ch <- c.WaitPodStatus(ctx, "ns", "non-existing-pod", corev1.PodRunning)
+		close(ch)
+	}(waitCh)
+
+	// interrupt the wait
+	cancel()
+
+	err := <-waitCh
+	require.Error(t, err, "Waiting must stop with an error when the context is cancelled.")
+	require.Equal(t, context.Canceled, pkgErrors.Cause(err), "The cause of the error must be the cancelled context.")
+}
+
 func fakeClientWithNS(t *testing.T) *client {
 	c := &client{
 		static: fake.NewSimpleClientset(),
@@ -1,6 +1,9 @@
 package kube
 
 import (
+	"context"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kyma-project/cli/pkg/api/octopus"
@@ -27,12 +30,28 @@ type KymaKube interface {
 	// IsPodDeployedByLabel checks if there is at least 1 pod in the given namespace with the given label  (independently of its status)
 	IsPodDeployedByLabel(namespace, labelName, labelValue string) (bool, error)
 
-	// WaitPodStatus waits for the given pod to reach the desired status.
-	WaitPodStatus(namespace, name string, status corev1.PodPhase) error
+	// WaitPodStatus waits for the given pod to reach the desired status or until the context is done.
+	WaitPodStatus(ctx context.Context, namespace, name string, status corev1.PodPhase) error
+
+	// WaitPodStatusByLabel selects a set of pods by label and waits for them or until the context is done.
+	WaitPodStatusByLabel(ctx context.Context, namespace, labelName, labelValue string, status corev1.PodPhase) error
+
+	// WaitPodsGone waits until no pod with the given label is left in the namespace or until the context is done.
+	WaitPodsGone(ctx context.Context, namespace, labelName, labelValue string) error
+
+	// WaitPods waits until there is at least 1 pod matching the label selector and all matching pods meet the condition.
+	// All following waits stop when the context is done or the timeout is reached, where a timeout of 0 means no timeout.
+	WaitPods(ctx context.Context, namespace, selector string, condition PodCondition, timeout time.Duration) error
+
+	// WaitPodsDeleted waits until no pod matching the label selector is left in the namespace.
+	WaitPodsDeleted(ctx context.Context, namespace, selector string, timeout time.Duration) error
+
+	// WaitDeploymentReady waits until all replicas of the given deployment are updated and available.
+	WaitDeploymentReady(ctx context.Context, namespace, name string, timeout time.Duration) error
 
-	// WaitPodStatusByLabel selects a set of pods by label and waits for them
-	WaitPodStatusByLabel(namespace, labelName, labelValue string, status corev1.PodPhase) error
+	// WaitStatefulSetReady waits until all replicas of the given stateful set are updated and ready.
+	WaitStatefulSetReady(ctx context.Context, namespace, name string, timeout time.Duration) error
 
-	// TODO we do not need more wait functions once deletion is not done via kubectl, the K8s API will wait on its own
-	WaitPodsGone(namespace, labelName, labelValue string) error
+	// WaitCRDEstablished waits until the given custom resource definition is established and can be used.
+	WaitCRDEstablished(ctx context.Context, name string, timeout time.Duration) error
 }
@@ -0,0 +1,232 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+var crdResource = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1beta1",
+	Resource: "customresourcedefinitions",
+}
+
+// PodCondition tells if a pod reached the state a wait is waiting for.
+type PodCondition func(pod *corev1.Pod) bool
+
+// PodReady is met when the pod and all its containers are ready to serve requests.
+func PodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// PodInPhase returns a condition which is met when the pod is in the given phase.
+func PodInPhase(phase corev1.PodPhase) PodCondition {
+	return func(pod *corev1.Pod) bool {
+		return pod.Status.Phase == phase
+	}
+}
+
+func (c *client) WaitPodStatus(ctx context.Context, namespace, name string, status corev1.PodPhase) error {
+	lw := c.podListWatch(namespace, metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String()})
+	return waitFor(ctx, 0, lw, &corev1.Pod{}, fmt.Sprintf("pod '%s/%s' to be %s", namespace, name, status), func(objs []interface{}) (bool, error) {
+		for _, obj := range objs {
+			if pod := obj.(*corev1.Pod); pod.Name == name {
+				return pod.Status.Phase == status, nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func (c *client) WaitPodStatusByLabel(ctx context.Context, namespace, labelName, labelValue string, status corev1.PodPhase) error {
+	return c.WaitPods(ctx, namespace, fmt.Sprintf("%s=%s", labelName, labelValue), PodInPhase(status), 0)
+}
+
+func (c *client) WaitPodsGone(ctx context.Context, namespace, labelName, labelValue string) error {
+	return c.WaitPodsDeleted(ctx, namespace, fmt.Sprintf("%s=%s", labelName, labelValue), 0)
+}
+
+func (c *client) WaitPods(ctx context.Context, namespace, selector string, condition PodCondition, timeout time.Duration) error {
+	lw := c.podListWatch(namespace, metav1.ListOptions{LabelSelector: selector})
+	return waitFor(ctx, timeout, lw, &corev1.Pod{}, fmt.Sprintf("pods '%s' in namespace '%s'", selector, namespace), func(objs []interface{}) (bool, error) {
+		if len(objs) == 0 {
+			return false, nil
+		}
+		for _, obj := range objs {
+			// if any pod does not meet the condition no need to check further
+			if !condition(obj.(*corev1.Pod)) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (c *client) WaitPodsDeleted(ctx context.Context, namespace, selector string, timeout time.Duration) error {
+	lw := c.podListWatch(namespace, metav1.ListOptions{LabelSelector: selector})
+	return waitFor(ctx, timeout, lw, &corev1.Pod{}, fmt.Sprintf("pods '%s' in namespace '%s' to be deleted", selector, namespace), func(objs []interface{}) (bool, error) {
+		return len(objs) == 0, nil
+	})
+}
+
+func (c *client) WaitDeploymentReady(ctx context.Context, namespace, name string, timeout time.Duration) error {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return c.Static().AppsV1().Deployments(namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return c.Static().AppsV1().Deployments(namespace).Watch(options)
+		},
+	}
+	return waitFor(ctx, timeout, lw, &appsv1.Deployment{}, fmt.Sprintf("deployment '%s/%s' to be ready", namespace, name), func(objs []interface{}) (bool, error) {
+		for _, obj := range objs {
+			if d := obj.(*appsv1.Deployment); d.Name == name {
+				return deploymentReady(d), nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func (c *client) WaitStatefulSetReady(ctx context.Context, namespace, name string, timeout time.Duration) error {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return c.Static().AppsV1().StatefulSets(namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return c.Static().AppsV1().StatefulSets(namespace).Watch(options)
+		},
+	}
+	return waitFor(ctx, timeout, lw, &appsv1.StatefulSet{}, fmt.Sprintf("statefulset '%s/%s' to be ready", namespace, name), func(objs []interface{}) (bool, error) {
+		for _, obj := range objs {
+			if s := obj.(*appsv1.StatefulSet); s.Name == name {
+				return statefulSetReady(s), nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func (c *client) WaitCRDEstablished(ctx context.Context, name string, timeout time.Duration) error {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", name).String()
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return c.Dynamic().Resource(crdResource).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return c.Dynamic().Resource(crdResource).Watch(options)
+		},
+	}
+	return waitFor(ctx, timeout, lw, &unstructured.Unstructured{}, fmt.Sprintf("CRD '%s' to be established", name), func(objs []interface{}) (bool, error) {
+		for _, obj := range objs {
+			if crd := obj.(*unstructured.Unstructured); crd.GetName() == name {
+				return crdEstablished(crd), nil
+			}
+		}
+		return false, nil
+	})
+}
+
+func (c *client) podListWatch(namespace string, opts metav1.ListOptions) cache.ListerWatcher {
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = opts.LabelSelector
+			options.FieldSelector = opts.FieldSelector
+			return c.Static().CoreV1().Pods(namespace).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = opts.LabelSelector
+			options.FieldSelector = opts.FieldSelector
+			return c.Static().CoreV1().Pods(namespace).Watch(options)
+		},
+	}
+}
+
+// waitFor watches the objects of the given ListerWatcher and evaluates the check against all currently known objects
+// on every change, until it is met, the timeout is reached or the context is done. A timeout of 0 means no timeout.
+func waitFor(ctx context.Context, timeout time.Duration, lw cache.ListerWatcher, objType runtime.Object, what string, check func(objs []interface{}) (bool, error)) error {
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	// the store is always updated before the events are delivered, so it holds the latest state when checking
+	var store cache.Store
+	precondition := func(s cache.Store) (bool, error) {
+		store = s
+		return check(store.List())
+	}
+	_, err := watchtools.UntilWithSync(ctx, lw, objType, precondition, func(watch.Event) (bool, error) {
+		return check(store.List())
+	})
+
+	if ctx.Err() != nil {
+		return errors.Wrapf(ctx.Err(), "stopped waiting for %s", what)
+	}
+	return errors.Wrapf(err, "while waiting for %s", what)
+}
+
+func deploymentReady(d *appsv1.Deployment) bool {
+	if d.Status.ObservedGeneration < d.Generation {
+		return false
+	}
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+	return d.Status.UpdatedReplicas == replicas && d.Status.AvailableReplicas == replicas && d.Status.Replicas == replicas
+}
+
+func statefulSetReady(s *appsv1.StatefulSet) bool {
+	if s.Status.ObservedGeneration < s.Generation {
+		return false
+	}
+	replicas := int32(1)
+	if s.Spec.Replicas != nil {
+		replicas = *s.Spec.Replicas
+	}
+	return s.Status.ReadyReplicas == replicas && s.Status.CurrentRevision == s.Status.UpdateRevision
+}
+
+func crdEstablished(crd *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cond["type"] == "Established" {
+			return cond["status"] == "True"
+		}
+	}
+	return false
+}
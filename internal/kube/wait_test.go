@@ -0,0 +1,159 @@
+package kube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pkgErrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestWaitPodsReady(t *testing.T) {
+	// setup
+	c := fakeClientWithNS(t)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-pod1",
+			Labels: map[string]string{"team": "huskies", "role": "sled"},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+		},
+	}
+	_, err := c.Static().CoreV1().Pods("ns").Create(pod)
+	require.NoError(t, err)
+
+	// wait for the pod to be ready in a separate goroutine
+	waitCh := make(chan error)
+	go func(ch chan<- error) {
+		ch <- c.WaitPods(context.Background(), "ns", "team=huskies,role=sled", PodReady, 5*time.Second)
+		close(ch)
+	}(waitCh)
+
+	// wait a bit and set the pod to ready
+	time.Sleep(500 * time.Millisecond)
+	pod.Status.Conditions[0].Status = corev1.ConditionTrue
+	_, err = c.Static().CoreV1().Pods("ns").UpdateStatus(pod)
+	require.NoError(t, err)
+
+	// we block waiting for the pod to change its state
+	require.NoError(t, <-waitCh)
+}
+
+func TestWaitPodsTimeout(t *testing.T) {
+	// setup
+	c := fakeClientWithNS(t)
+
+	// no pods at all must not satisfy the wait
+	err := c.WaitPods(context.Background(), "ns", "team=huskies", PodInPhase(corev1.PodRunning), 500*time.Millisecond)
+	require.Error(t, err, "Waiting for non existing pods must time out.")
+	require.Equal(t, context.DeadlineExceeded, pkgErrors.Cause(err), "The cause of the error must be the reached timeout.")
+}
+
+func TestWaitPodsDeleted(t *testing.T) {
+	// setup
+	c := fakeClientWithNS(t)
+	_, err := c.Static().CoreV1().Pods("ns").Create(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test-pod1",
+			Labels: map[string]string{"team": "huskies"},
+		},
+	})
+	require.NoError(t, err)
+
+	// wait for the pod to be deleted in a separate goroutine
+	waitCh := make(chan error)
+	go func(ch chan<- error) {
+		ch <- c.WaitPodsDeleted(context.Background(), "ns", "team=huskies", 5*time.Second)
+		close(ch)
+	}(waitCh)
+
+	// wait a bit and delete the pod
+	time.Sleep(500 * time.Millisecond)
+	require.NoError(t, c.Static().CoreV1().Pods("ns").Delete("test-pod1", &metav1.DeleteOptions{}))
+
+	require.NoError(t, <-waitCh)
+}
+
+func TestWaitDeploymentReady(t *testing.T) {
+	// setup
+	c := fakeClientWithNS(t)
+	replicas := int32(2)
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, AvailableReplicas: 2},
+	}
+	_, err := c.Static().AppsV1().Deployments("ns").Create(d)
+	require.NoError(t, err)
+
+	// wait for the deployment to be ready in a separate goroutine
+	waitCh := make(chan error)
+	go func(ch chan<- error) {
+		ch <- c.WaitDeploymentReady(context.Background(), "ns", "test-deployment", 5*time.Second)
+		close(ch)
+	}(waitCh)
+
+	// wait a bit and let the deployment controller observe the new generation
+	time.Sleep(500 * time.Millisecond)
+	d.Status.ObservedGeneration = 2
+	_, err = c.Static().AppsV1().Deployments("ns").UpdateStatus(d)
+	require.NoError(t, err)
+
+	require.NoError(t, <-waitCh)
+}
+
+func TestWaitStatefulSetReady(t *testing.T) {
+	// setup
+	c := fakeClientWithNS(t)
+	s := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-statefulset"},
+		Status:     appsv1.StatefulSetStatus{ReadyReplicas: 0, CurrentRevision: "rev1", UpdateRevision: "rev1"},
+	}
+	_, err := c.Static().AppsV1().StatefulSets("ns").Create(s)
+	require.NoError(t, err)
+
+	// a stateful set which is not ready must make the wait time out
+	err = c.WaitStatefulSetReady(context.Background(), "ns", "test-statefulset", 500*time.Millisecond)
+	require.Error(t, err, "Waiting for a stateful set which is not ready must time out.")
+
+	s.Status.ReadyReplicas = 1
+	_, err = c.Static().AppsV1().StatefulSets("ns").UpdateStatus(s)
+	require.NoError(t, err)
+	require.NoError(t, c.WaitStatefulSetReady(context.Background(), "ns", "test-statefulset", 5*time.Second))
+}
+
+func TestWaitCRDEstablished(t *testing.T) {
+	// setup
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1beta1")
+	crd.SetKind("CustomResourceDefinition")
+	crd.SetName("installations.installer.kyma-project.io")
+	c := &client{dynamic: dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(), crd)}
+
+	// wait for the CRD to be established in a separate goroutine
+	waitCh := make(chan error)
+	go func(ch chan<- error) {
+		ch <- c.WaitCRDEstablished(context.Background(), "installations.installer.kyma-project.io", 5*time.Second)
+		close(ch)
+	}(waitCh)
+
+	// wait a bit and establish the CRD
+	time.Sleep(500 * time.Millisecond)
+	require.NoError(t, unstructured.SetNestedSlice(crd.Object, []interface{}{
+		map[string]interface{}{"type": "NamesAccepted", "status": "True"},
+		map[string]interface{}{"type": "Established", "status": "True"},
+	}, "status", "conditions"))
+	_, err := c.Dynamic().Resource(crdResource).Update(crd, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, <-waitCh)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
@@ -96,6 +97,7 @@ func fixPodWithContainers(containersName ...string) v1.Pod {
 // in current implementation (client-go v1.12) returns zero-value `rest.Request`
 // and executing GetLogs(/*..*/).DoRaw() method, throws panic.
 type fakePodGetter struct {
+	mu           sync.Mutex
 	podName      string
 	podNamespace string
 	containers   []string
@@ -119,16 +121,22 @@ func newFakePodsGetter(t *testing.T, pod v1.Pod, podlogs string) (*fakePodGetter
 }
 
 func (f *fakePodGetter) Pods(namespace string) corev1.PodInterface {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.podNamespace = namespace
 	return f
 }
 
 func (f *fakePodGetter) Get(name string, options metav1.GetOptions) (*v1.Pod, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.podName = name
 	return &f.pod, nil
 }
 
 func (f *fakePodGetter) GetLogs(name string, opts *v1.PodLogOptions) *rest.Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.containers = append(f.containers, opts.Container)
 	return rest.NewRequest(nil, http.MethodGet, f.url, "", rest.ContentConfig{}, rest.Serializers{}, nil, nil, 0)
 }
@@ -0,0 +1,227 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// maxLineSize is the biggest log line which can be streamed from a container
+const maxLineSize = 1024 * 1024
+
+var (
+	// podStartPollInterval defines how often a pending testing pod is checked before attaching to its logs
+	podStartPollInterval = time.Second
+
+	prefixColors = []*color.Color{
+		color.New(color.FgCyan),
+		color.New(color.FgGreen),
+		color.New(color.FgMagenta),
+		color.New(color.FgYellow),
+		color.New(color.FgBlue),
+		color.New(color.FgHiCyan),
+		color.New(color.FgHiGreen),
+		color.New(color.FgHiMagenta),
+	}
+)
+
+// FollowerForTestingPods streams the logs of all testing pods of a test suite as they start.
+// The logs of all containers are multiplexed into one output, each line prefixed with the test and container name.
+type FollowerForTestingPods struct {
+	ignoredContainers map[string]struct{}
+	podCli            v1.PodsGetter
+	out               io.Writer
+
+	mu       sync.Mutex
+	followed map[string]struct{}
+	cancels  []context.CancelFunc
+	colors   int
+	errs     []error
+	wg       sync.WaitGroup
+	// done is closed once the test suite is finished, pods which did not start until then are not followed anymore
+	done chan struct{}
+}
+
+// NewFollowerForTestingPods returns new instance of the FollowerForTestingPods
+func NewFollowerForTestingPods(podCli v1.PodsGetter, ignoredContainers []string, out io.Writer) *FollowerForTestingPods {
+	f := FollowerForTestingPods{
+		ignoredContainers: map[string]struct{}{},
+		podCli:            podCli,
+		out:               out,
+		followed:          map[string]struct{}{},
+		done:              make(chan struct{}),
+	}
+
+	for _, c := range ignoredContainers {
+		f.ignoredContainers[c] = struct{}{}
+	}
+
+	return &f
+}
+
+// Follow attaches to the logs of all executions of the given test suite which are not followed yet.
+// It does not block, the logs are streamed until the containers terminate or the context is done.
+func (f *FollowerForTestingPods) Follow(ctx context.Context, suite *oct.ClusterTestSuite) {
+	for _, result := range suite.Status.Results {
+		for i, exec := range result.Executions {
+			key := fmt.Sprintf("%s/%s", result.Namespace, exec.ID)
+			f.mu.Lock()
+			_, found := f.followed[key]
+			f.followed[key] = struct{}{}
+			f.mu.Unlock()
+			if found {
+				continue
+			}
+
+			name := result.Name
+			if i > 0 {
+				// retries and repeated executions of the same test get their own prefix
+				name = fmt.Sprintf("%s#%d", name, i+1)
+			}
+
+			podCtx, cancel := context.WithCancel(ctx)
+			f.mu.Lock()
+			f.cancels = append(f.cancels, cancel)
+			f.mu.Unlock()
+
+			f.wg.Add(1)
+			go func(ctx context.Context, namespace, podName, testName string) {
+				defer f.wg.Done()
+				defer cancel()
+				if err := f.followPod(ctx, namespace, podName, testName); err != nil && ctx.Err() == nil {
+					f.mu.Lock()
+					f.errs = append(f.errs, err)
+					f.mu.Unlock()
+				}
+			}(podCtx, result.Namespace, exec.ID, name)
+		}
+	}
+}
+
+// Wait is called once the test suite is finished. It stops waiting for pods which did not start,
+// gives the running log streams the grace period to finish and returns the first error which occurred while streaming.
+func (f *FollowerForTestingPods) Wait(grace time.Duration) error {
+	close(f.done)
+
+	finished := make(chan struct{})
+	go func() {
+		f.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(grace):
+		// streams of containers which did not terminate in time are cut off
+		f.mu.Lock()
+		for _, cancel := range f.cancels {
+			cancel()
+		}
+		f.mu.Unlock()
+		<-finished
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.errs) > 0 {
+		return f.errs[0]
+	}
+	return nil
+}
+
+func (f *FollowerForTestingPods) followPod(ctx context.Context, namespace, podName, testName string) error {
+	pod, err := f.waitPodStarted(ctx, namespace, podName)
+	if err != nil || pod == nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(pod.Spec.Containers))
+	for _, c := range pod.Spec.Containers {
+		if _, skip := f.ignoredContainers[c.Name]; skip {
+			continue
+		}
+
+		wg.Add(1)
+		go func(container string) {
+			defer wg.Done()
+			if err := f.streamContainer(ctx, namespace, podName, container, f.prefix(testName, container)); err != nil {
+				errCh <- err
+			}
+		}(c.Name)
+	}
+	wg.Wait()
+	close(errCh)
+
+	return <-errCh
+}
+
+// waitPodStarted polls the pod until it is not pending anymore, so that its containers are able to serve logs.
+// It returns no pod if the pod is still pending when the test suite is finished.
+func (f *FollowerForTestingPods) waitPodStarted(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
+	for finished := false; ; {
+		pod, err := f.podCli.Pods(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting %q pod", name)
+		}
+		if pod.Status.Phase != corev1.PodPending {
+			return pod, nil
+		}
+		if finished {
+			return nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-f.done:
+			// check the pod one last time, it might have started since the last poll
+			finished = true
+		case <-time.After(podStartPollInterval):
+		}
+	}
+}
+
+func (f *FollowerForTestingPods) streamContainer(ctx context.Context, namespace, podName, container, prefix string) error {
+	stream, err := f.podCli.Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: container,
+		Follow:    true,
+	}).Context(ctx).Stream()
+	if err != nil {
+		return errors.Wrapf(err, "while streaming logs of container %q in pod %q", container, podName)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for scanner.Scan() {
+		f.mu.Lock()
+		fmt.Fprintf(f.out, "%s %s\n", prefix, scanner.Text())
+		f.mu.Unlock()
+	}
+
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return errors.Wrapf(err, "while reading logs of container %q in pod %q", container, podName)
+	}
+	return nil
+}
+
+// prefix returns the colored prefix for the log lines of a container, every container gets the next color
+func (f *FollowerForTestingPods) prefix(testName, container string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	c := prefixColors[f.colors%len(prefixColors)]
+	f.colors++
+	return c.Sprintf("[%s/%s]", testName, container)
+}
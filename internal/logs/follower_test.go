@@ -0,0 +1,77 @@
+package logs_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/internal/logs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/api/core/v1"
+)
+
+func TestFollower(t *testing.T) {
+	const fixLogsResponse = "Lorem ipsum\ndolor sit amet."
+	color.NoColor = true
+
+	// given
+	pod := fixPodWithContainers("test", "istio-proxy")
+	pod.Status.Phase = v1.PodRunning
+	fakeCli, cleanup := newFakePodsGetter(t, pod, fixLogsResponse)
+	defer cleanup()
+
+	out := &bytes.Buffer{}
+	follower := logs.NewFollowerForTestingPods(fakeCli, []string{"istio-proxy"}, out)
+
+	result := fixFailedTestResultForPod(pod)
+	result.Name = "test-pico"
+	suite := &oct.ClusterTestSuite{Status: oct.TestSuiteStatus{Results: []oct.TestResult{result}}}
+
+	// when
+	follower.Follow(context.Background(), suite)
+	// following the same suite again must not attach to the pod twice
+	follower.Follow(context.Background(), suite)
+	err := follower.Wait(time.Second)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "[test-pico/test] Lorem ipsum\n[test-pico/test] dolor sit amet.\n", out.String())
+	assert.Equal(t, []string{"test"}, fakeCli.containers)
+	assert.False(t, strings.Contains(out.String(), "istio-proxy"), "Ignored containers must not be followed.")
+}
+
+func TestFollowerPodNeverStarted(t *testing.T) {
+	color.NoColor = true
+
+	// given
+	pod := fixPodWithContainers("test")
+	pod.Status.Phase = v1.PodPending
+	fakeCli, cleanup := newFakePodsGetter(t, pod, "never streamed")
+	defer cleanup()
+
+	out := &bytes.Buffer{}
+	follower := logs.NewFollowerForTestingPods(fakeCli, nil, out)
+	suite := &oct.ClusterTestSuite{Status: oct.TestSuiteStatus{Results: []oct.TestResult{fixFailedTestResultForPod(pod)}}}
+
+	// when the suite is finished while the pod is still pending
+	follower.Follow(context.Background(), suite)
+	done := make(chan error)
+	go func() {
+		done <- follower.Wait(time.Minute)
+	}()
+
+	// then
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Waiting for the follower must not block on pods which never start.")
+	}
+	assert.Empty(t, out.String())
+	assert.Empty(t, fakeCli.containers)
+}
@@ -15,6 +15,13 @@ import (
 
 const (
 	minikubeVersion string = "1.6.2"
+	// the docker driver is available since this version
+	dockerDriverVersion string = "1.8.0"
+
+	//DriverNone runs Kubernetes directly on the host with the Docker daemon of the host
+	DriverNone = "none"
+	//DriverDocker runs the Minikube node as a container of the Docker daemon of the host
+	DriverDocker = "docker"
 )
 
 //RunCmd executes a minikube command with given arguments
@@ -52,14 +59,7 @@ func RunCmd(verbose bool, profile string, rawArgs ...string) (string, error) {
 
 //CheckVersion checks whether minikube version is supported
 func CheckVersion(verbose bool) (string, error) {
-	versionText, err := RunCmd(verbose, "", "version")
-	if err != nil {
-		return "", err
-	}
-
-	exp, _ := regexp.Compile("minikube version: v(.*)")
-	versionString := exp.FindStringSubmatch(versionText)
-	version, err := semver.NewVersion(versionString[1])
+	version, err := getVersion(verbose)
 	if err != nil {
 		return "", err
 	}
@@ -77,8 +77,64 @@ func CheckVersion(verbose bool) (string, error) {
 	return fmt.Sprintf("You are using an unsupported Minikube version '%s'. This may not work. The recommended Minikube version is '%s'", version, minikubeVersion), nil
 }
 
-//DockerClient creates a docker client based on minikube "docker-env" configuration
-func DockerClient(verbose bool, profile string) (*docker.Client, error) {
+//CheckDriver checks the requirements of drivers without VM: the Docker daemon of the host must be running, and the installed Minikube must support the driver
+func CheckDriver(verbose bool, driver string) error {
+	if driver != DriverNone && driver != DriverDocker {
+		return nil
+	}
+
+	version, err := getVersion(verbose)
+	if err != nil {
+		return err
+	}
+	if err := checkDriverVersion(driver, version); err != nil {
+		return err
+	}
+
+	dc, err := docker.NewClientFromEnv()
+	if err == nil {
+		err = dc.Ping()
+	}
+	if err != nil {
+		return fmt.Errorf("The '%s' driver requires a running Docker daemon on this machine, but it is not accessible: %s", driver, err)
+	}
+	return nil
+}
+
+func checkDriverVersion(driver string, version *semver.Version) error {
+	if driver != DriverDocker {
+		return nil
+	}
+	constraint, err := semver.NewConstraint(">=" + dockerDriverVersion)
+	if err != nil {
+		return err
+	}
+	if !constraint.Check(version) {
+		return fmt.Errorf("The '%s' driver requires Minikube '%s' or higher, but you are using Minikube '%s'", driver, dockerDriverVersion, version)
+	}
+	return nil
+}
+
+func getVersion(verbose bool) (*semver.Version, error) {
+	versionText, err := RunCmd(verbose, "", "version")
+	if err != nil {
+		return nil, err
+	}
+
+	exp, _ := regexp.Compile("minikube version: v(.*)")
+	versionString := exp.FindStringSubmatch(versionText)
+	if len(versionString) < 2 {
+		return nil, fmt.Errorf("Cannot read the Minikube version from '%s'", strings.TrimSpace(versionText))
+	}
+	return semver.NewVersion(versionString[1])
+}
+
+//DockerClient creates a docker client based on minikube "docker-env" configuration.
+//With the none driver, Minikube uses the Docker daemon of the host, which has no "docker-env" configuration.
+func DockerClient(verbose bool, profile, driver string) (*docker.Client, error) {
+	if driver == DriverNone {
+		return docker.NewClientFromEnv()
+	}
 	envOut, err := RunCmd(verbose, profile, "docker-env", "--shell", "bash")
 	if err != nil {
 		return nil, err
@@ -0,0 +1,15 @@
+package minikube
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDriverVersion(t *testing.T) {
+	require.NoError(t, checkDriverVersion(DriverDocker, semver.MustParse("1.8.0")))
+	require.NoError(t, checkDriverVersion(DriverDocker, semver.MustParse("1.9.2")))
+	require.Error(t, checkDriverVersion(DriverDocker, semver.MustParse("1.6.2")), "the docker driver does not exist in older versions")
+	require.NoError(t, checkDriverVersion(DriverNone, semver.MustParse("1.6.2")))
+}
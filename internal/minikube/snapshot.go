@@ -0,0 +1,159 @@
+package minikube
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultProfile = "minikube"
+	snapshotsDir   = "minikube-snapshots"
+)
+
+//MachineDir returns the folder in which Minikube keeps the VM of the profile
+func MachineDir(profile string) (string, error) {
+	home := os.Getenv("MINIKUBE_HOME")
+	if home == "" {
+		u, err := user.Current()
+		if err != nil {
+			return "", err
+		}
+		home = u.HomeDir
+	}
+	// MINIKUBE_HOME may point to the .minikube folder itself or to its parent
+	if filepath.Base(home) != ".minikube" {
+		home = filepath.Join(home, ".minikube")
+	}
+	if profile == "" {
+		profile = defaultProfile
+	}
+	return filepath.Join(home, "machines", profile), nil
+}
+
+//SnapshotDir returns the folder of the snapshots of the profile inside the given Kyma CLI home folder
+func SnapshotDir(kymaHome, profile string) string {
+	if profile == "" {
+		profile = defaultProfile
+	}
+	return filepath.Join(kymaHome, snapshotsDir, profile)
+}
+
+//SaveSnapshot copies the VM of the stopped Minikube machine into a snapshot with the given name
+func SaveSnapshot(machineDir, snapshotDir, name string) error {
+	if err := checkSnapshotDriver(machineDir); err != nil {
+		return err
+	}
+	target := filepath.Join(snapshotDir, name)
+	if _, err := os.Stat(target); err == nil {
+		return fmt.Errorf("The snapshot '%s' already exists", name)
+	}
+	if err := copyDir(machineDir, target); err != nil {
+		os.RemoveAll(target)
+		return errors.Wrapf(err, "Could not save the snapshot '%s'", name)
+	}
+	return nil
+}
+
+//RestoreSnapshot replaces the VM of the stopped Minikube machine with the snapshot with the given name
+func RestoreSnapshot(machineDir, snapshotDir, name string) error {
+	source := filepath.Join(snapshotDir, name)
+	if _, err := os.Stat(source); err != nil {
+		snapshots, _ := ListSnapshots(snapshotDir)
+		return fmt.Errorf("The snapshot '%s' does not exist, the available snapshots are: %s", name, strings.Join(snapshots, ", "))
+	}
+	if err := checkSnapshotDriver(machineDir); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(machineDir); err != nil {
+		return errors.Wrap(err, "Could not remove the Minikube machine")
+	}
+	return errors.Wrapf(copyDir(source, machineDir), "Could not restore the snapshot '%s'", name)
+}
+
+//ListSnapshots returns the names of the snapshots in the given folder
+func ListSnapshots(snapshotDir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(snapshotDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// checkSnapshotDriver makes sure the machine runs in a VM, as the drivers without VM keep the cluster outside of the machine folder
+func checkSnapshotDriver(machineDir string) error {
+	data, err := ioutil.ReadFile(filepath.Join(machineDir, "config.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("There is no Minikube machine in %s", machineDir)
+		}
+		return err
+	}
+	machine := struct {
+		DriverName string
+	}{}
+	if err := json.Unmarshal(data, &machine); err != nil {
+		return errors.Wrap(err, "Could not read the configuration of the Minikube machine")
+	}
+	if machine.DriverName == DriverNone || machine.DriverName == DriverDocker {
+		return fmt.Errorf("Snapshots are not supported with the '%s' driver, as it does not run the cluster in a VM", machine.DriverName)
+	}
+	return nil
+}
+
+func copyDir(source, target string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(target, rel)
+		if info.IsDir() {
+			return os.MkdirAll(dest, info.Mode().Perm()|0700)
+		}
+		if !info.Mode().IsRegular() {
+			// sockets and pid files of the running VM are not part of the snapshot
+			return nil
+		}
+		return copyFile(path, dest, info.Mode().Perm())
+	})
+}
+
+func copyFile(source, target string, mode os.FileMode) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
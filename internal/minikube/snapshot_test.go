@@ -0,0 +1,45 @@
+package minikube
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshots(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "kyma-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	machineDir := filepath.Join(tmp, "machines", "kyma")
+	snapshotDir := SnapshotDir(tmp, "kyma")
+	require.Error(t, SaveSnapshot(machineDir, snapshotDir, "installed"), "there is no machine yet")
+
+	require.NoError(t, os.MkdirAll(machineDir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(machineDir, "config.json"), []byte(`{"DriverName": "hyperkit"}`), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(machineDir, "kyma.rawdisk"), []byte("installed"), 0600))
+
+	require.NoError(t, SaveSnapshot(machineDir, snapshotDir, "installed"))
+	require.Error(t, SaveSnapshot(machineDir, snapshotDir, "installed"), "snapshots must not be overwritten")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(machineDir, "kyma.rawdisk"), []byte("broken"), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(machineDir, "hyperkit.pid"), []byte("42"), 0600))
+	require.Error(t, RestoreSnapshot(machineDir, snapshotDir, "unknown"))
+	require.NoError(t, RestoreSnapshot(machineDir, snapshotDir, "installed"))
+
+	disk, err := ioutil.ReadFile(filepath.Join(machineDir, "kyma.rawdisk"))
+	require.NoError(t, err)
+	require.Equal(t, "installed", string(disk))
+	_, err = os.Stat(filepath.Join(machineDir, "hyperkit.pid"))
+	require.True(t, os.IsNotExist(err), "files created after the snapshot must be removed")
+
+	snapshots, err := ListSnapshots(snapshotDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"installed"}, snapshots)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(machineDir, "config.json"), []byte(`{"DriverName": "docker"}`), 0600))
+	require.Error(t, SaveSnapshot(machineDir, snapshotDir, "docker"), "the drivers without VM keep the cluster outside of the machine folder")
+}
@@ -0,0 +1,198 @@
+package pricing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kyma-incubator/hydroform/provision/types"
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/kyma-project/cli/pkg/step"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// HoursPerMonth is the number of hours the cloud providers bill for a month
+const HoursPerMonth = 730
+
+// FileName is the name of the pricing file in the Kyma CLI home directory which updates the shipped prices
+const FileName = "pricing.yaml"
+
+// defaultPrices are the on-demand list prices in USD in us-central1, eastus, and us-east-1, without discounts and taxes.
+// They are meant for an estimate before provisioning only, prices of other regions and newer prices are set with the pricing file.
+const defaultPrices = `gcp:
+  clusterHour: 0.10
+  diskGBMonth: 0.04
+  machines:
+    n1-standard-1: 0.0475
+    n1-standard-2: 0.095
+    n1-standard-4: 0.19
+    n1-standard-8: 0.38
+    n1-standard-16: 0.76
+    n1-standard-32: 1.52
+    n1-highmem-4: 0.2368
+    n1-highmem-8: 0.4736
+    e2-standard-4: 0.134
+    e2-standard-8: 0.268
+azure:
+  clusterHour: 0
+  diskGBMonth: 0.048
+  machines:
+    Standard_D2_v3: 0.096
+    Standard_D4_v3: 0.192
+    Standard_D8_v3: 0.384
+    Standard_D16_v3: 0.768
+    Standard_D4s_v3: 0.192
+    Standard_D8s_v3: 0.384
+aws:
+  clusterHour: 0.10
+  diskGBMonth: 0.10
+  machines:
+    m5.large: 0.096
+    m5.xlarge: 0.192
+    m5.2xlarge: 0.384
+    m5.4xlarge: 0.768
+    c5.2xlarge: 0.34
+`
+
+// Prices are the prices of a provider in USD
+type Prices struct {
+	// ClusterHour is the management fee of a cluster per hour
+	ClusterHour float64 `json:"clusterHour"`
+	// DiskGBMonth is the price of one GB of node disk per month
+	DiskGBMonth float64 `json:"diskGBMonth"`
+	// Machines are the prices of a node per hour by machine type
+	Machines map[string]float64 `json:"machines"`
+}
+
+// Table contains the prices of all providers
+type Table map[string]*Prices
+
+// priceUpdate are the prices of a provider in the pricing file, where unset prices keep the shipped ones
+type priceUpdate struct {
+	ClusterHour *float64           `json:"clusterHour"`
+	DiskGBMonth *float64           `json:"diskGBMonth"`
+	Machines    map[string]float64 `json:"machines"`
+}
+
+// Estimate is the estimated cost of a cluster in USD
+type Estimate struct {
+	Hourly  float64
+	Monthly float64
+}
+
+// Limits are the monthly cost in USD above which provisioning must be confirmed or fails, 0 disables the limit
+type Limits struct {
+	Confirm float64
+	Max     float64
+}
+
+// Load reads the shipped prices and updates them with the pricing file in the given directory if it exists
+func Load(dir string) (Table, error) {
+	t := Table{}
+	if err := yaml.UnmarshalStrict([]byte(defaultPrices), &t); err != nil {
+		return nil, errors.Wrap(err, "while parsing the shipped prices")
+	}
+
+	path := filepath.Join(dir, FileName)
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	updates := map[string]*priceUpdate{}
+	if err := yaml.UnmarshalStrict(data, &updates); err != nil {
+		return nil, errors.Wrapf(err, "while parsing the pricing file %s", path)
+	}
+	t.update(updates)
+	return t, nil
+}
+
+// update overrides the prices with the ones set in the updates, the machine types are merged
+func (t Table) update(updates map[string]*priceUpdate) {
+	for provider, u := range updates {
+		if u == nil {
+			continue
+		}
+		p, ok := t[provider]
+		if !ok {
+			p = &Prices{}
+			t[provider] = p
+		}
+		if u.ClusterHour != nil {
+			p.ClusterHour = *u.ClusterHour
+		}
+		if u.DiskGBMonth != nil {
+			p.DiskGBMonth = *u.DiskGBMonth
+		}
+		if p.Machines == nil {
+			p.Machines = map[string]float64{}
+		}
+		for machine, price := range u.Machines {
+			p.Machines[machine] = price
+		}
+	}
+}
+
+// Estimate returns the cost of a cluster with the given nodes of the provider
+func (t Table) Estimate(provider, machineType string, nodes, diskSizeGB int) (Estimate, error) {
+	p, ok := t[provider]
+	if !ok {
+		return Estimate{}, fmt.Errorf("no prices for the provider %s", provider)
+	}
+	machine, ok := p.Machines[machineType]
+	if !ok {
+		return Estimate{}, fmt.Errorf("no price for the machine type %s of the provider %s, known types are: %s", machineType, provider, strings.Join(p.machineTypes(), ", "))
+	}
+
+	hourly := p.ClusterHour + float64(nodes)*(machine+float64(diskSizeGB)*p.DiskGBMonth/HoursPerMonth)
+	return Estimate{Hourly: hourly, Monthly: hourly * HoursPerMonth}, nil
+}
+
+func (p *Prices) machineTypes() []string {
+	var types []string
+	for m := range p.Machines {
+		types = append(types, m)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Confirm estimates the cost of the cluster and checks it against the limits.
+// Above the confirmation limit, the user must confirm the cost unless the shell is non-interactive, above the maximum cost the check always fails.
+// The provider is the cloud provider billing the cluster, which is the target provider for Gardener clusters.
+func Confirm(s step.Step, provider string, cluster *types.Cluster, l Limits, nonInteractive bool) error {
+	home, err := files.KymaHome()
+	if err != nil {
+		return err
+	}
+	t, err := Load(home)
+	if err != nil {
+		return err
+	}
+
+	e, err := t.Estimate(provider, cluster.MachineType, cluster.NodeCount, cluster.DiskSizeGB)
+	if err != nil {
+		if l.Max > 0 {
+			return errors.Wrapf(err, "The cost of the cluster cannot be checked against the maximum cost, add the prices to the %s file in the Kyma CLI home directory", FileName)
+		}
+		s.LogErrorf("Cannot estimate the cost of the cluster: %s", err)
+		return nil
+	}
+
+	s.LogInfof("Estimated cost of %d x %s with %d GB disk: %.2f USD per hour, %.2f USD per month", cluster.NodeCount, cluster.MachineType, cluster.DiskSizeGB, e.Hourly, e.Monthly)
+	if l.Max > 0 && e.Monthly > l.Max {
+		return fmt.Errorf("The estimated cost of %.2f USD per month exceeds the maximum cost of %.2f USD", e.Monthly, l.Max)
+	}
+	if l.Confirm > 0 && e.Monthly > l.Confirm && !nonInteractive {
+		if !s.PromptYesNo(fmt.Sprintf("The estimated cost exceeds %.2f USD per month. Do you want to provision the cluster? ", l.Confirm)) {
+			return errors.New("Provisioning aborted")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package pricing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pricing")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	table, err := Load(dir)
+	require.NoError(t, err, "the pricing file is optional")
+	require.Equal(t, 0.19, table["gcp"].Machines["n1-standard-4"])
+
+	pricingFile := `gcp:
+  clusterHour: 0.12
+  diskGBMonth: 0.05
+  machines:
+    n1-standard-4: 0.21
+    n2-standard-4: 0.23
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, FileName), []byte(pricingFile), 0600))
+	table, err = Load(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0.12, table["gcp"].ClusterHour)
+	require.Equal(t, 0.21, table["gcp"].Machines["n1-standard-4"], "the file must update the shipped prices")
+	require.Equal(t, 0.23, table["gcp"].Machines["n2-standard-4"], "the file must add machine types")
+	require.Equal(t, 0.38, table["gcp"].Machines["n1-standard-8"], "machine types missing in the file must be kept")
+	require.Equal(t, 0.192, table["azure"].Machines["Standard_D4_v3"], "providers missing in the file must be kept")
+
+	// a file which only adds machine types must keep the shipped cluster and disk prices
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, FileName), []byte("aws:\n  machines:\n    m5.8xlarge: 1.536\n"), 0600))
+	table, err = Load(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1.536, table["aws"].Machines["m5.8xlarge"])
+	require.Equal(t, 0.10, table["aws"].ClusterHour, "unset prices must not be zeroed")
+	require.Equal(t, 0.10, table["aws"].DiskGBMonth, "unset prices must not be zeroed")
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, FileName), []byte("gcp:\n  machine: {}\n"), 0600))
+	_, err = Load(dir)
+	require.Error(t, err, "unknown fields must be rejected")
+}
+
+func TestEstimate(t *testing.T) {
+	table := Table{"gcp": {ClusterHour: 0.1, DiskGBMonth: 0.073, Machines: map[string]float64{"n1-standard-4": 0.2}}}
+
+	e, err := table.Estimate("gcp", "n1-standard-4", 10, 100)
+	require.NoError(t, err)
+	// 0.1 + 10 * (0.2 + 100 * 0.073 / 730)
+	require.InDelta(t, 2.2, e.Hourly, 0.0001)
+	require.InDelta(t, 2.2*HoursPerMonth, e.Monthly, 0.0001)
+
+	_, err = table.Estimate("gcp", "n1-standard-16", 10, 100)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "n1-standard-4", "the error must list the known machine types")
+
+	_, err = table.Estimate("azure", "Standard_D2_v3", 3, 30)
+	require.Error(t, err)
+}
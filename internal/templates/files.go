@@ -0,0 +1,21 @@
+// Code generated by go generate from resources/templates; DO NOT EDIT.
+
+package templates
+
+var files = map[string]string{
+	"lambda-nodejs12/deploy/deployment.yaml.tmpl": "# This is a template deployment file that can be used for future deployments\n\n{{if .Expose }}apiVersion: gateway.kyma-project.io/v1alpha2\nkind: Api\nmetadata:\n  name: {{ .Name }}-api\n  namespace: {{ .Namespace }}\nspec:\n  authentication: []\n  hostname: {{ .Name }}.{{ .ClusterDomain }}\n  service:\n    name: {{ .Name }}\n    port: 8080\n---{{end}}\napiVersion: kubeless.io/v1beta1\nkind: Function\nmetadata:\n  name: {{ .Name }}\n  namespace: {{ .Namespace }}\n  labels:\n    app: {{ .Name }}\nspec:\n  function: |-\n    !!Your Function code here!!\n  runtime: nodejs12\n  type: HTTP\n  handler: handler.main",
+	"lambda-nodejs12/handler.js.tmpl":             "module.exports = {\n  main: function (event, context) {\n    return 'Hello World';\n  }\n};",
+	"lambda-nodejs12/kyma.json.tmpl":              "{\n  \"name\": \"{{ .Name }}\",\n  \"namespace\": \"{{ .Namespace }}\",\n  \"expose\": {{ .Expose }},\n  \"clusterDomain\": \"{{ .ClusterDomain }}\",\n  \"file\": \"handler.js\",\n  \"runtime\": \"nodejs12\"\n}",
+	"lambda-nodejs12/local/index.js.tmpl":         "/*jshint esversion: 6 */\n\nvar handler = require('../handler.js');\nvar http = require('http');\n\nconst blue = \"\\x1b[34m\";\nconst green = \"\\x1b[32m\";\nconst red = \"\\x1b[31m\";\nconst reset = \"\\x1b[0m\";\n\nhttp.createServer(function (req, res) {\n  let data = [];\n  req.on('data', chunk => {\n    data.push(chunk);\n  });\n  req.on('end', () => {\n      console.log(`${blue}Headers${reset}: ${JSON.stringify(req.headers)}`);\n      console.log(`${blue}Request${reset}: ${req.method}`);\n      var event = {};\n      if(data.length > 0) {\n        event = { data: JSON.parse(data)};\n        console.log(`${blue}Event${reset}:`, JSON.stringify(event, null, 4));\n      }\n\n      var handlerResult = handler.main(event, req);\n      Promise.resolve(handlerResult)\n      .then(result => {\n        if(result) {\n          console.log(`${green}Result${reset}`, JSON.stringify(result, null, 4));  \n          res.write(JSON.stringify(result));\n        } else {\n          console.log(`${green}Result${reset} is empty`);\n        }\n        res.end();\n      }).catch(err => {\n        console.log(`${red}Error${reset}`, err);\n        res.write(err);\n        res.statusCode = 500;\n        res.end();\n      });\n  });\n}).listen(8080);",
+	"lambda-nodejs12/package.json.tmpl":           "{\n  \"name\": \"{{ .Name }}\",\n  \"version\": \"1.0.0\",\n  \"description\": \"\",\n  \"main\": \"handler.js\",\n  \"scripts\": {\n    \"start\": \"nodemon --inspect local/index.js\"\n  },\n  \"author\": \"\",\n  \"license\": \"ISC\"\n}\n",
+	"lambda-nodejs8/deploy/deployment.yaml.tmpl":  "# This is a template deployment file that can be used for future deployments\n\n{{if .Expose }}apiVersion: gateway.kyma-project.io/v1alpha2\nkind: Api\nmetadata:\n  name: {{ .Name }}-api\n  namespace: {{ .Namespace }}\nspec:\n  authentication: []\n  hostname: {{ .Name }}.{{ .ClusterDomain }}\n  service:\n    name: {{ .Name }}\n    port: 8080\n---{{end}}\napiVersion: kubeless.io/v1beta1\nkind: Function\nmetadata:\n  name: {{ .Name }}\n  namespace: {{ .Namespace }}\n  labels:\n    app: {{ .Name }}\nspec:\n  function: |-\n    !!Your Function code here!!\n  runtime: nodejs8\n  type: HTTP\n  handler: handler.main",
+	"lambda-nodejs8/handler.js.tmpl":              "module.exports = {\n  main: function (event, context) {\n    return 'Hello World';\n  }\n};",
+	"lambda-nodejs8/kyma.json.tmpl":               "{\n  \"name\": \"{{ .Name }}\",\n  \"namespace\": \"{{ .Namespace }}\",\n  \"expose\": {{ .Expose }},\n  \"clusterDomain\": \"{{ .ClusterDomain }}\",\n  \"file\": \"handler.js\",\n  \"runtime\": \"nodejs8\"\n}",
+	"lambda-nodejs8/local/index.js.tmpl":          "/*jshint esversion: 6 */\n\nvar handler = require('../handler.js');\nvar http = require('http');\n\nconst blue = \"\\x1b[34m\";\nconst green = \"\\x1b[32m\";\nconst red = \"\\x1b[31m\";\nconst reset = \"\\x1b[0m\";\n\nhttp.createServer(function (req, res) {\n  let data = [];\n  req.on('data', chunk => {\n    data.push(chunk);\n  });\n  req.on('end', () => {\n      console.log(`${blue}Headers${reset}: ${JSON.stringify(req.headers)}`);\n      console.log(`${blue}Request${reset}: ${req.method}`);\n      var event = {};\n      if(data.length > 0) {\n        event = { data: JSON.parse(data)};\n        console.log(`${blue}Event${reset}:`, JSON.stringify(event, null, 4));\n      }\n\n      var handlerResult = handler.main(event, req);\n      Promise.resolve(handlerResult)\n      .then(result => {\n        if(result) {\n          console.log(`${green}Result${reset}`, JSON.stringify(result, null, 4));  \n          res.write(JSON.stringify(result));\n        } else {\n          console.log(`${green}Result${reset} is empty`);\n        }\n        res.end();\n      }).catch(err => {\n        console.log(`${red}Error${reset}`, err);\n        res.write(err);\n        res.statusCode = 500;\n        res.end();\n      });\n  });\n}).listen(8080);",
+	"lambda-nodejs8/package.json.tmpl":            "{\n  \"name\": \"{{ .Name }}\",\n  \"version\": \"1.0.0\",\n  \"description\": \"\",\n  \"main\": \"handler.js\",\n  \"scripts\": {\n    \"start\": \"nodemon --inspect local/index.js\"\n  },\n  \"author\": \"\",\n  \"license\": \"ISC\"\n}\n",
+	"lambda-python3/deploy/deployment.yaml.tmpl":  "# This is a template deployment file that can be used for future deployments\n\n{{if .Expose }}apiVersion: gateway.kyma-project.io/v1alpha2\nkind: Api\nmetadata:\n  name: {{ .Name }}-api\n  namespace: {{ .Namespace }}\nspec:\n  authentication: []\n  hostname: {{ .Name }}.{{ .ClusterDomain }}\n  service:\n    name: {{ .Name }}\n    port: 8080\n---{{end}}\napiVersion: kubeless.io/v1beta1\nkind: Function\nmetadata:\n  name: {{ .Name }}\n  namespace: {{ .Namespace }}\n  labels:\n    app: {{ .Name }}\nspec:\n  function: |-\n    !!Your Function code here!!\n  runtime: python3.7\n  type: HTTP\n  handler: handler.main",
+	"lambda-python3/handler.py.tmpl":              "def main(event, context):\n    return \"Hello World\"\n",
+	"lambda-python3/kyma.json.tmpl":               "{\n  \"name\": \"{{ .Name }}\",\n  \"namespace\": \"{{ .Namespace }}\",\n  \"expose\": {{ .Expose }},\n  \"clusterDomain\": \"{{ .ClusterDomain }}\",\n  \"file\": \"handler.py\",\n  \"runtime\": \"python3.7\"\n}\n",
+	"lambda-python3/local/server.py.tmpl":         "# Runs the function locally on port 8080 the way the Kyma runtime calls it\nimport json\nimport os\nimport sys\nfrom http.server import BaseHTTPRequestHandler, HTTPServer\n\nsys.path.insert(0, os.path.join(os.path.dirname(__file__), \"..\"))\nimport handler\n\nBLUE = \"\\x1b[34m\"\nGREEN = \"\\x1b[32m\"\nRED = \"\\x1b[31m\"\nRESET = \"\\x1b[0m\"\n\n\nclass FunctionHandler(BaseHTTPRequestHandler):\n    def handle_request(self):\n        print(\"{}Headers{}: {}\".format(BLUE, RESET, json.dumps(dict(self.headers))))\n        print(\"{}Request{}: {}\".format(BLUE, RESET, self.command))\n        event = {}\n        length = int(self.headers.get(\"Content-Length\", 0))\n        if length > 0:\n            event = {\"data\": json.loads(self.rfile.read(length))}\n            print(\"{}Event{}: {}\".format(BLUE, RESET, json.dumps(event, indent=4)))\n\n        try:\n            result = handler.main(event, self)\n        except Exception as err:\n            print(\"{}Error{}: {}\".format(RED, RESET, err))\n            self.send_response(500)\n            self.end_headers()\n            self.wfile.write(str(err).encode())\n            return\n\n        self.send_response(200)\n        self.end_headers()\n        if result is None:\n            print(\"{}Result{} is empty\".format(GREEN, RESET))\n            return\n        print(\"{}Result{}: {}\".format(GREEN, RESET, json.dumps(result, indent=4)))\n        self.wfile.write(json.dumps(result).encode())\n\n    do_GET = handle_request\n    do_POST = handle_request\n\n\nHTTPServer((\"\", 8080), FunctionHandler).serve_forever()\n",
+	"lambda-python3/requirements.txt.tmpl":        "# dependencies of the function, one requirement per line\n",
+}
@@ -0,0 +1,126 @@
+//go:generate go run gen.go
+
+// Package templates provides the template files of the Kyma CLI, which are embedded into the binary from the resources/templates directory.
+// After changing the templates, run `go generate ./internal/templates` to embed them again.
+package templates
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FS is a file system templates are read from
+type FS interface {
+	// Walk walks the file tree rooted at root like filepath.Walk
+	Walk(root string, walkFn filepath.WalkFunc) error
+	// ReadFile reads the named file like ioutil.ReadFile
+	ReadFile(name string) ([]byte, error)
+}
+
+// Embedded contains the templates embedded into the binary
+var Embedded FS = memFS(files)
+
+// Dir returns the file system of the local directory, paths are relative to the directory
+func Dir(dir string) FS {
+	return osFS(dir)
+}
+
+type osFS string
+
+func (fs osFS) Walk(root string, walkFn filepath.WalkFunc) error {
+	base := string(fs)
+	return filepath.Walk(filepath.Join(base, root), func(p string, info os.FileInfo, err error) error {
+		rel, relErr := filepath.Rel(base, p)
+		if relErr != nil {
+			return relErr
+		}
+		return walkFn(filepath.ToSlash(rel), info, err)
+	})
+}
+
+func (fs osFS) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(string(fs), filepath.FromSlash(name)))
+}
+
+// memFS maps slash-separated file paths to their content, directories are implied by the paths
+type memFS map[string]string
+
+func (fs memFS) Walk(root string, walkFn filepath.WalkFunc) error {
+	root = path.Clean(root)
+	entries := map[string]bool{} // path -> is directory
+	for name := range fs {
+		if !strings.HasPrefix(name, root+"/") {
+			continue
+		}
+		entries[name] = false
+		for dir := path.Dir(name); dir != root; dir = path.Dir(dir) {
+			entries[dir] = true
+		}
+	}
+	if len(entries) == 0 {
+		return walkFn(root, nil, &os.PathError{Op: "walk", Path: root, Err: os.ErrNotExist})
+	}
+	entries[root] = true
+
+	var names []string
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var skipped []string
+	for _, name := range names {
+		if isSkipped(name, skipped) {
+			continue
+		}
+		err := walkFn(name, memFileInfo{name: path.Base(name), size: int64(len(fs[name])), dir: entries[name]}, nil)
+		if err == filepath.SkipDir && entries[name] {
+			skipped = append(skipped, name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSkipped(name string, skipped []string) bool {
+	for _, dir := range skipped {
+		if strings.HasPrefix(name, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (fs memFS) ReadFile(name string) ([]byte, error) {
+	content, ok := fs[path.Clean(filepath.ToSlash(name))]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return []byte(content), nil
+}
+
+type memFileInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (fi memFileInfo) Name() string { return fi.name }
+func (fi memFileInfo) Size() int64  { return fi.size }
+func (fi memFileInfo) Mode() os.FileMode {
+	if fi.dir {
+		return os.ModeDir | 0755
+	}
+	return 0644
+}
+func (fi memFileInfo) ModTime() time.Time { return time.Time{} }
+func (fi memFileInfo) IsDir() bool        { return fi.dir }
+func (fi memFileInfo) Sys() interface{}   { return nil }
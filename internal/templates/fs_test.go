@@ -0,0 +1,66 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// walk returns the walked paths with a trailing slash for directories
+func walk(t *testing.T, fs FS, root string) []string {
+	var paths []string
+	require.NoError(t, fs.Walk(root, func(path string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		if info.IsDir() {
+			path += "/"
+		}
+		paths = append(paths, path)
+		return nil
+	}))
+	return paths
+}
+
+func TestEmbeddedUpToDate(t *testing.T) {
+	disk := Dir("../../resources/templates")
+	for _, name := range []string{"lambda-nodejs8", "lambda-nodejs12", "lambda-python3"} {
+		require.Equal(t, walk(t, disk, name), walk(t, Embedded, name), "run `go generate ./internal/templates` to embed the changed templates")
+	}
+	for name := range files {
+		want, err := disk.ReadFile(name)
+		require.NoError(t, err, "run `go generate ./internal/templates` to remove deleted templates")
+		got, err := Embedded.ReadFile(name)
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(got), "run `go generate ./internal/templates` to embed the changed templates")
+	}
+}
+
+func TestMemFS(t *testing.T) {
+	fs := memFS{
+		"set/a.tmpl":      "a",
+		"set/sub/b.tmpl":  "b",
+		"set/skip/c.tmpl": "c",
+		"other/d.tmpl":    "d",
+		"settings/e.tmpl": "e",
+	}
+
+	var paths []string
+	require.NoError(t, fs.Walk("set", func(path string, info os.FileInfo, err error) error {
+		require.NoError(t, err)
+		if info.IsDir() && info.Name() == "skip" {
+			return filepath.SkipDir
+		}
+		paths = append(paths, path)
+		return nil
+	}))
+	require.Equal(t, []string{"set", "set/a.tmpl", "set/sub", "set/sub/b.tmpl"}, paths)
+
+	content, err := fs.ReadFile("set/sub/b.tmpl")
+	require.NoError(t, err)
+	require.Equal(t, "b", string(content))
+
+	_, err = fs.ReadFile("set/missing.tmpl")
+	require.True(t, os.IsNotExist(err))
+	require.Error(t, fs.Walk("missing", func(_ string, _ os.FileInfo, err error) error { return err }))
+}
@@ -0,0 +1,60 @@
+// +build ignore
+
+// gen embeds the files of the resources/templates directory into files.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const source = "../../resources/templates"
+
+func main() {
+	files := map[string]string{}
+	err := filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by go generate from resources/templates; DO NOT EDIT.\n\npackage templates\n\nvar files = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%q: %q,\n", name, files[name])
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("files.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+package testhistory
+
+import (
+	"sort"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+)
+
+// ChangeType describes how a test changed between two runs
+type ChangeType string
+
+const (
+	// Regressed tests succeeded before and failed afterwards
+	Regressed ChangeType = "Regressed"
+	// Fixed tests failed before and succeeded afterwards
+	Fixed ChangeType = "Fixed"
+	// Added tests were not part of the first run
+	Added ChangeType = "Added"
+	// Removed tests are not part of the second run
+	Removed ChangeType = "Removed"
+	// Slower tests took significantly longer than before
+	Slower ChangeType = "Slower"
+	// Faster tests took significantly less time than before
+	Faster ChangeType = "Faster"
+)
+
+// Change is a single difference of a test between two runs
+type Change struct {
+	Test           string        `json:"test"`
+	Type           ChangeType    `json:"type"`
+	StatusBefore   string        `json:"statusBefore,omitempty"`
+	StatusAfter    string        `json:"statusAfter,omitempty"`
+	DurationBefore time.Duration `json:"durationBefore,omitempty"`
+	DurationAfter  time.Duration `json:"durationAfter,omitempty"`
+}
+
+// DiffOptions define when the duration of a test changed significantly
+type DiffOptions struct {
+	// DurationThreshold is the relative change of the duration, 0.5 means 50% slower or faster
+	DurationThreshold float64
+	// MinDurationChange is the absolute change of the duration below which changes are ignored
+	MinDurationChange time.Duration
+}
+
+// Compare returns the changes of all tests from the before run to the after run, sorted by test name
+func Compare(before, after Run, opts DiffOptions) []Change {
+	beforeResults := indexResults(before)
+	afterResults := indexResults(after)
+
+	var changes []Change
+	for name, b := range beforeResults {
+		a, found := afterResults[name]
+		if !found {
+			changes = append(changes, Change{Test: name, Type: Removed, StatusBefore: b.Status})
+			continue
+		}
+
+		change := Change{
+			Test:           name,
+			StatusBefore:   b.Status,
+			StatusAfter:    a.Status,
+			DurationBefore: b.Duration(),
+			DurationAfter:  a.Duration(),
+		}
+		switch {
+		case b.Status == string(oct.TestSucceeded) && a.Status == string(oct.TestFailed):
+			change.Type = Regressed
+		case b.Status == string(oct.TestFailed) && a.Status == string(oct.TestSucceeded):
+			change.Type = Fixed
+		case significantChange(change.DurationBefore, change.DurationAfter, opts):
+			change.Type = Faster
+			if change.DurationAfter > change.DurationBefore {
+				change.Type = Slower
+			}
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	for name, a := range afterResults {
+		if _, found := beforeResults[name]; !found {
+			changes = append(changes, Change{Test: name, Type: Added, StatusAfter: a.Status})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Test < changes[j].Test
+	})
+	return changes
+}
+
+// Regressions returns only the changes of tests which regressed
+func Regressions(changes []Change) []Change {
+	var result []Change
+	for _, c := range changes {
+		if c.Type == Regressed {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Duration returns the total duration of all executions of the test
+func (r Result) Duration() time.Duration {
+	var total time.Duration
+	for _, e := range r.Executions {
+		total += e.Duration
+	}
+	return total
+}
+
+func indexResults(run Run) map[string]Result {
+	results := map[string]Result{}
+	for _, r := range run.Results {
+		name := r.Name
+		if r.Namespace != "" {
+			name = r.Namespace + "/" + r.Name
+		}
+		results[name] = r
+	}
+	return results
+}
+
+func significantChange(before, after time.Duration, opts DiffOptions) bool {
+	if before <= 0 || after <= 0 {
+		return false
+	}
+	delta := after - before
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta < opts.MinDurationChange {
+		return false
+	}
+	return float64(delta)/float64(before) > opts.DurationThreshold
+}
@@ -0,0 +1,36 @@
+package testhistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	before := Run{Results: []Result{
+		fixResult("regressed", "Succeeded", execution("Succeeded", time.Minute)),
+		fixResult("fixed", "Failed", execution("Failed", time.Minute)),
+		fixResult("slower", "Succeeded", execution("Succeeded", time.Minute)),
+		fixResult("jitter", "Succeeded", execution("Succeeded", 2*time.Second)),
+		fixResult("removed", "Succeeded", execution("Succeeded", time.Minute)),
+	}}
+	after := Run{Results: []Result{
+		fixResult("regressed", "Failed", execution("Failed", time.Minute)),
+		fixResult("fixed", "Succeeded", execution("Failed", time.Minute), execution("Succeeded", time.Minute)),
+		fixResult("slower", "Succeeded", execution("Succeeded", 3*time.Minute)),
+		fixResult("jitter", "Succeeded", execution("Succeeded", 4*time.Second)),
+		fixResult("added", "Succeeded", execution("Succeeded", time.Minute)),
+	}}
+
+	changes := Compare(before, after, DiffOptions{DurationThreshold: 0.5, MinDurationChange: 10 * time.Second})
+
+	require.Equal(t, []Change{
+		{Test: "added", Type: Added, StatusAfter: "Succeeded"},
+		{Test: "fixed", Type: Fixed, StatusBefore: "Failed", StatusAfter: "Succeeded", DurationBefore: time.Minute, DurationAfter: 2 * time.Minute},
+		{Test: "regressed", Type: Regressed, StatusBefore: "Succeeded", StatusAfter: "Failed", DurationBefore: time.Minute, DurationAfter: time.Minute},
+		{Test: "removed", Type: Removed, StatusBefore: "Succeeded"},
+		{Test: "slower", Type: Slower, StatusBefore: "Succeeded", StatusAfter: "Succeeded", DurationBefore: time.Minute, DurationAfter: 3 * time.Minute},
+	}, changes, "Changes of the duration below the minimum must be ignored.")
+	require.Len(t, Regressions(changes), 1)
+}
@@ -0,0 +1,117 @@
+package testhistory
+
+import (
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+// Run is an archived, finished test suite
+type Run struct {
+	Suite          string    `json:"suite"`
+	Status         string    `json:"status"`
+	MaxRetries     int64     `json:"maxRetries"`
+	Count          int64     `json:"count"`
+	StartTime      time.Time `json:"startTime"`
+	CompletionTime time.Time `json:"completionTime"`
+	Results        []Result  `json:"results"`
+}
+
+// Result is the archived result of a single test of a test suite
+type Result struct {
+	Name       string      `json:"name"`
+	Namespace  string      `json:"namespace"`
+	Status     string      `json:"status"`
+	Executions []Execution `json:"executions"`
+	Logs       string      `json:"logs,omitempty"`
+}
+
+// Execution is a single execution (testing pod) of a test
+type Execution struct {
+	ID       string        `json:"id"`
+	PodPhase string        `json:"podPhase"`
+	Duration time.Duration `json:"duration"`
+	Reason   string        `json:"reason,omitempty"`
+	Message  string        `json:"message,omitempty"`
+}
+
+// Duration returns how long the test suite was running
+func (r Run) Duration() time.Duration {
+	if r.StartTime.IsZero() || r.CompletionTime.IsZero() {
+		return 0
+	}
+	return r.CompletionTime.Sub(r.StartTime)
+}
+
+// logsFetcher allows you to fetch logs from the testing pods
+type logsFetcher interface {
+	Logs(result oct.TestResult) (string, error)
+}
+
+// NewRun converts a finished test suite into a run. Logs are only fetched if a logs fetcher is given.
+func NewRun(suite *oct.ClusterTestSuite, fetcher logsFetcher) (*Run, error) {
+	status, finished := suiteStatus(suite)
+	if !finished {
+		return nil, errors.Errorf("test suite '%s' is not finished yet", suite.Name)
+	}
+
+	run := &Run{
+		Suite:      suite.Name,
+		Status:     status,
+		MaxRetries: suite.Spec.MaxRetries,
+		Count:      suite.Spec.Count,
+	}
+	if suite.Status.StartTime != nil {
+		run.StartTime = suite.Status.StartTime.Time
+	}
+	// CompletionTime is not set when test suite is timed out
+	if suite.Status.CompletionTime != nil {
+		run.CompletionTime = suite.Status.CompletionTime.Time
+	}
+
+	for _, r := range suite.Status.Results {
+		result := Result{
+			Name:      r.Name,
+			Namespace: r.Namespace,
+			Status:    string(r.Status),
+		}
+		for _, e := range r.Executions {
+			exec := Execution{
+				ID:       e.ID,
+				PodPhase: string(e.PodPhase),
+				Reason:   e.Reason,
+				Message:  e.Message,
+			}
+			if e.StartTime != nil && e.CompletionTime != nil {
+				exec.Duration = e.CompletionTime.Sub(e.StartTime.Time)
+			}
+			result.Executions = append(result.Executions, exec)
+		}
+
+		if fetcher != nil && len(r.Executions) > 0 {
+			logs, err := fetcher.Logs(r)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while fetching logs of test '%s'", r.Name)
+			}
+			result.Logs = logs
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	return run, nil
+}
+
+// suiteStatus returns the final condition of the test suite and if the suite is finished at all
+func suiteStatus(suite *oct.ClusterTestSuite) (string, bool) {
+	for _, cond := range suite.Status.Conditions {
+		if cond.Status != oct.StatusTrue {
+			continue
+		}
+		switch cond.Type {
+		case oct.SuiteSucceeded, oct.SuiteFailed, oct.SuiteError:
+			return string(cond.Type), true
+		}
+	}
+	return "", false
+}
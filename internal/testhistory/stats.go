@@ -0,0 +1,120 @@
+package testhistory
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TestStats summarizes the results of a single test across all archived runs
+type TestStats struct {
+	Name string `json:"name"`
+	// Runs is the number of runs in which the test was executed, skipped tests are not counted
+	Runs   int `json:"runs"`
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
+	// Flaky is the number of runs in which the test passed only after being retried
+	Flaky       int           `json:"flaky"`
+	PassRate    float64       `json:"passRate"`
+	AvgDuration time.Duration `json:"avgDuration"`
+}
+
+// IsFlaky tells if the test passed only after retries at least once
+func (s TestStats) IsFlaky() bool {
+	return s.Flaky > 0
+}
+
+// Analyze calculates the statistics of all tests of the given runs, sorted by test name
+func Analyze(runs []Run) []TestStats {
+	stats := map[string]*TestStats{}
+	durations := map[string][]time.Duration{}
+
+	for _, run := range runs {
+		for _, r := range run.Results {
+			if r.Status == string(oct.TestSkipped) || len(r.Executions) == 0 {
+				continue
+			}
+
+			s, ok := stats[r.Name]
+			if !ok {
+				s = &TestStats{Name: r.Name}
+				stats[r.Name] = s
+			}
+			s.Runs++
+
+			switch r.Status {
+			case string(oct.TestSucceeded):
+				s.Passed++
+				if retried(r) {
+					s.Flaky++
+				}
+			case string(oct.TestFailed):
+				s.Failed++
+			}
+
+			for _, e := range r.Executions {
+				if e.Duration > 0 {
+					durations[r.Name] = append(durations[r.Name], e.Duration)
+				}
+			}
+		}
+	}
+
+	result := make([]TestStats, 0, len(stats))
+	for name, s := range stats {
+		s.PassRate = float64(s.Passed) / float64(s.Runs)
+		if d := durations[name]; len(d) > 0 {
+			var total time.Duration
+			for _, v := range d {
+				total += v
+			}
+			s.AvgDuration = total / time.Duration(len(d))
+		}
+		result = append(result, *s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// WriteCSV writes the statistics as CSV including a header line
+func WriteCSV(out io.Writer, stats []TestStats) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"test", "runs", "passed", "failed", "flaky", "pass_rate", "avg_duration_seconds"}); err != nil {
+		return errors.Wrap(err, "while writing CSV header")
+	}
+	for _, s := range stats {
+		record := []string{
+			s.Name,
+			fmt.Sprint(s.Runs),
+			fmt.Sprint(s.Passed),
+			fmt.Sprint(s.Failed),
+			fmt.Sprint(s.Flaky),
+			fmt.Sprintf("%.2f", s.PassRate),
+			fmt.Sprintf("%.1f", s.AvgDuration.Seconds()),
+		}
+		if err := w.Write(record); err != nil {
+			return errors.Wrapf(err, "while writing CSV record of test '%s'", s.Name)
+		}
+	}
+	w.Flush()
+	return errors.Wrap(w.Error(), "while writing CSV")
+}
+
+// retried tells if a test needed more than one attempt because an earlier execution failed
+func retried(r Result) bool {
+	for _, e := range r.Executions {
+		if e.PodPhase == string(corev1.PodFailed) {
+			return true
+		}
+	}
+	return false
+}
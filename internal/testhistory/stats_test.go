@@ -0,0 +1,47 @@
+package testhistory
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	runs := []Run{
+		{Results: []Result{
+			fixResult("api", "Succeeded", execution("Succeeded", time.Minute)),
+			fixResult("ui", "Failed", execution("Failed", 10*time.Second), execution("Failed", 20*time.Second)),
+		}},
+		{Results: []Result{
+			fixResult("api", "Succeeded", execution("Failed", time.Minute), execution("Succeeded", 2*time.Minute)),
+			fixResult("ui", "Succeeded", execution("Succeeded", 30*time.Second)),
+			fixResult("skipped", "Skipped"),
+		}},
+	}
+
+	stats := Analyze(runs)
+
+	require.Len(t, stats, 2, "Skipped tests must not be analyzed.")
+	require.Equal(t, TestStats{Name: "api", Runs: 2, Passed: 2, Flaky: 1, PassRate: 1, AvgDuration: 80 * time.Second}, stats[0])
+	require.True(t, stats[0].IsFlaky())
+	require.Equal(t, TestStats{Name: "ui", Runs: 2, Passed: 1, Failed: 1, PassRate: 0.5, AvgDuration: 20 * time.Second}, stats[1])
+	require.False(t, stats[1].IsFlaky(), "A test which failed after all retries is not flaky.")
+}
+
+func TestWriteCSV(t *testing.T) {
+	out := &bytes.Buffer{}
+	err := WriteCSV(out, []TestStats{{Name: "api", Runs: 2, Passed: 2, Flaky: 1, PassRate: 1, AvgDuration: 80 * time.Second}})
+
+	require.NoError(t, err)
+	require.Equal(t, "test,runs,passed,failed,flaky,pass_rate,avg_duration_seconds\napi,2,2,0,1,1.00,80.0\n", out.String())
+}
+
+func fixResult(name, status string, executions ...Execution) Result {
+	return Result{Name: name, Status: status, Executions: executions}
+}
+
+func execution(phase string, d time.Duration) Execution {
+	return Execution{PodPhase: phase, Duration: d}
+}
@@ -0,0 +1,96 @@
+// Package testhistory archives finished test suites locally and analyzes the results across runs.
+package testhistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/pkg/errors"
+)
+
+const historyFolder = "test-history"
+
+// Store keeps archived test suite runs as JSON files in a directory
+type Store struct {
+	dir string
+}
+
+// NewStore returns a store using the given directory
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// DefaultStore returns the store in the Kyma CLI home folder
+func DefaultStore() (*Store, error) {
+	home, err := files.KymaHome()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not find the Kyma CLI home folder")
+	}
+	return NewStore(filepath.Join(home, historyFolder)), nil
+}
+
+// Save archives the run. Archiving the same run again overwrites the previous archive.
+func (s *Store) Save(run *Run) (string, error) {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return "", errors.Wrap(err, "could not create the test history folder")
+	}
+
+	data, err := json.MarshalIndent(run, "", "  ")
+	if err != nil {
+		return "", errors.Wrapf(err, "could not marshal run of test suite '%s'", run.Suite)
+	}
+
+	path := filepath.Join(s.dir, fileName(run))
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return "", errors.Wrapf(err, "could not archive run of test suite '%s'", run.Suite)
+	}
+	return path, nil
+}
+
+// Runs returns all archived runs, the oldest first
+func (s *Store) Runs() ([]Run, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the test history folder")
+	}
+
+	var runs []Run
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, e.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read archived run '%s'", e.Name())
+		}
+		run := Run{}
+		if err := json.Unmarshal(data, &run); err != nil {
+			return nil, errors.Wrapf(err, "could not parse archived run '%s'", e.Name())
+		}
+		runs = append(runs, run)
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].CompletionTime.Before(runs[j].CompletionTime)
+	})
+	return runs, nil
+}
+
+func fileName(run *Run) string {
+	name := strings.Map(func(r rune) rune {
+		if r == os.PathSeparator || r == '/' {
+			return '_'
+		}
+		return r
+	}, run.Suite)
+	return fmt.Sprintf("%s-%d.json", name, run.StartTime.Unix())
+}
@@ -0,0 +1,85 @@
+package testhistory
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeFetcher struct{}
+
+func (fakeFetcher) Logs(result oct.TestResult) (string, error) {
+	return "logs of " + result.Name, nil
+}
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "test-history")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	store := NewStore(dir)
+
+	// an empty store has no runs
+	runs, err := store.Runs()
+	require.NoError(t, err)
+	require.Empty(t, runs)
+
+	// archive two runs, the newer one first
+	start := time.Date(2020, 2, 1, 10, 0, 0, 0, time.UTC)
+	newer, err := NewRun(fixSuite("suite-b", start.Add(time.Hour)), fakeFetcher{})
+	require.NoError(t, err)
+	_, err = store.Save(newer)
+	require.NoError(t, err)
+	older, err := NewRun(fixSuite("suite-a", start), fakeFetcher{})
+	require.NoError(t, err)
+	_, err = store.Save(older)
+	require.NoError(t, err)
+
+	runs, err = store.Runs()
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	require.Equal(t, "suite-a", runs[0].Suite, "Runs must be sorted by completion time.")
+	require.Equal(t, "suite-b", runs[1].Suite)
+	require.Equal(t, 5*time.Minute, runs[0].Duration())
+	require.Equal(t, "logs of test-a", runs[0].Results[0].Logs)
+	require.Equal(t, time.Minute, runs[0].Results[0].Executions[0].Duration)
+}
+
+func TestNewRunNotFinished(t *testing.T) {
+	suite := fixSuite("suite", time.Now())
+	suite.Status.Conditions = []oct.TestSuiteCondition{{Type: oct.SuiteRunning, Status: oct.StatusTrue}}
+
+	_, err := NewRun(suite, nil)
+	require.Error(t, err, "Archiving a running test suite must fail.")
+}
+
+func fixSuite(name string, start time.Time) *oct.ClusterTestSuite {
+	return &oct.ClusterTestSuite{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: oct.TestSuiteStatus{
+			StartTime:      &metav1.Time{Time: start},
+			CompletionTime: &metav1.Time{Time: start.Add(5 * time.Minute)},
+			Conditions:     []oct.TestSuiteCondition{{Type: oct.SuiteSucceeded, Status: oct.StatusTrue}},
+			Results: []oct.TestResult{
+				{
+					Name:      "test-a",
+					Namespace: "kyma-system",
+					Status:    oct.TestSucceeded,
+					Executions: []oct.TestExecution{
+						{
+							ID:             "test-a-0",
+							PodPhase:       corev1.PodSucceeded,
+							StartTime:      &metav1.Time{Time: start},
+							CompletionTime: &metav1.Time{Time: start.Add(time.Minute)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
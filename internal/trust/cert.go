@@ -0,0 +1,103 @@
+package trust
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseCertificate parses a PEM or DER encoded certificate.
+func ParseCertificate(data []byte) (*x509.Certificate, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("Unexpected PEM block of type '%s', expected a certificate", block.Type)
+		}
+		data = block.Bytes
+	}
+
+	cert, err := x509.ParseCertificate(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "Could not parse the certificate")
+	}
+	return cert, nil
+}
+
+// LoadCertificate reads and parses the certificate in the given file.
+func LoadCertificate(file string) (*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not read the certificate file '%s'", file)
+	}
+	return ParseCertificate(data)
+}
+
+// Domain returns the domain the certificate was issued for, without any wildcard.
+func Domain(cert *x509.Certificate) (string, error) {
+	names := cert.DNSNames
+	if len(names) == 0 && cert.Subject.CommonName != "" {
+		names = []string{cert.Subject.CommonName}
+	}
+	if len(names) == 0 {
+		return "", errors.New("Could not determine the certificate's DNS")
+	}
+	return strings.TrimPrefix(names[0], "*."), nil
+}
+
+// ParseCertificates parses all PEM encoded certificates in the given data and skips any other content.
+func ParseCertificates(data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			certs = append(certs, cert)
+		}
+	}
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the certificate in the usual colon separated hex format.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(hex, ":")
+}
+
+// EncodePEM encodes the certificate in the PEM format.
+func EncodePEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// sha1Hash returns the SHA-1 hash of the certificate, which OS certificate tools use to identify certificates.
+func sha1Hash(cert *x509.Certificate) string {
+	return fmt.Sprintf("%X", sha1.Sum(cert.Raw))
+}
+
+// isKymaCertificate tells if the certificate looks like a Kyma root certificate, which is self-signed for a wildcard domain.
+// It is used for stores which do not allow to identify Kyma certificates by their name.
+func isKymaCertificate(cert *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+		return false
+	}
+	for _, name := range cert.DNSNames {
+		if strings.HasPrefix(name, "*.") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// +build linux
+
+package trust
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/pkg/errors"
+)
+
+const osReleaseFile = "/etc/os-release"
+
+// caStore describes how a Linux distribution manages its trusted root certificates.
+type caStore struct {
+	// dir is the folder for additional trusted root certificates.
+	dir string
+	// update refreshes the trusted certificates after the content of dir changed.
+	update []string
+}
+
+var (
+	debianStore = caStore{dir: "/usr/local/share/ca-certificates", update: []string{"update-ca-certificates"}}
+	fedoraStore = caStore{dir: "/etc/pki/ca-trust/source/anchors", update: []string{"update-ca-trust", "extract"}}
+	// Arch manages its anchors with the trust tool of p11-kit, which is the same that 'trust anchor' does,
+	// but keeping the file name allows to identify the Kyma certificates later on.
+	archStore   = caStore{dir: "/etc/ca-certificates/trust-source/anchors", update: []string{"trust", "extract-compat"}}
+	alpineStore = caStore{dir: "/usr/local/share/ca-certificates", update: []string{"update-ca-certificates"}}
+
+	// distroStores maps the IDs of /etc/os-release to the layout of the distribution.
+	distroStores = map[string]caStore{
+		"debian":  debianStore,
+		"ubuntu":  debianStore,
+		"fedora":  fedoraStore,
+		"rhel":    fedoraStore,
+		"centos":  fedoraStore,
+		"arch":    archStore,
+		"manjaro": archStore,
+		"alpine":  alpineStore,
+	}
+)
+
+// certPath returns the path under which the certificate of the given domain is stored.
+func (s caStore) certPath(domain string) string {
+	return filepath.Join(s.dir, certName(domain)+".crt")
+}
+
+// systemCAStore detects the certificate store layout of the running Linux distribution.
+// If the distribution is unknown, the layout is guessed from the available update tools.
+func systemCAStore() (caStore, error) {
+	if osRelease, err := ioutil.ReadFile(osReleaseFile); err == nil {
+		if s, ok := detectCAStore(string(osRelease)); ok {
+			return s, nil
+		}
+	}
+
+	for _, s := range []caStore{debianStore, fedoraStore, archStore} {
+		if _, err := exec.LookPath(s.update[0]); err == nil {
+			return s, nil
+		}
+	}
+	return caStore{}, errors.New("Could not detect how your Linux distribution manages trusted certificates")
+}
+
+// detectCAStore determines the certificate store layout based on the content of /etc/os-release.
+func detectCAStore(osRelease string) (caStore, bool) {
+	var ids []string
+	scanner := bufio.NewScanner(strings.NewReader(osRelease))
+	for scanner.Scan() {
+		kv := strings.SplitN(strings.TrimSpace(scanner.Text()), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.Trim(kv[1], `"'`)
+		switch kv[0] {
+		case "ID":
+			// the ID of the distribution itself takes precedence over the ones it is like
+			ids = append([]string{value}, ids...)
+		case "ID_LIKE":
+			ids = append(ids, strings.Fields(value)...)
+		}
+	}
+
+	for _, id := range ids {
+		if s, ok := distroStores[id]; ok {
+			return s, true
+		}
+	}
+	return caStore{}, false
+}
+
+// runPrivileged runs the command directly when running as root, or with sudo otherwise.
+func runPrivileged(c string, args ...string) (string, error) {
+	if os.Geteuid() == 0 {
+		return cli.RunCmd(c, args...)
+	}
+	return cli.RunCmd("sudo", append([]string{c}, args...)...)
+}
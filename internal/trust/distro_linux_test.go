@@ -0,0 +1,32 @@
+// +build linux
+
+package trust
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectCAStore(t *testing.T) {
+	cases := []struct {
+		name      string
+		osRelease string
+		store     caStore
+		found     bool
+	}{
+		{name: "Ubuntu", osRelease: "NAME=\"Ubuntu\"\nID=ubuntu\nID_LIKE=debian\n", store: debianStore, found: true},
+		{name: "Fedora", osRelease: "NAME=Fedora\nID=fedora\n", store: fedoraStore, found: true},
+		{name: "CentOS", osRelease: "ID=\"centos\"\nID_LIKE=\"rhel fedora\"\n", store: fedoraStore, found: true},
+		{name: "Manjaro", osRelease: "ID=manjaro\nID_LIKE=arch\n", store: archStore, found: true},
+		{name: "Alpine", osRelease: "ID=alpine\nVERSION_ID=3.11.3\n", store: alpineStore, found: true},
+		{name: "derivative", osRelease: "ID=pop\nID_LIKE=\"ubuntu debian\"\n", store: debianStore, found: true},
+		{name: "unknown", osRelease: "ID=nixos\n", found: false},
+	}
+
+	for _, c := range cases {
+		s, found := detectCAStore(c.osRelease)
+		require.Equal(t, c.found, found, c.name)
+		require.Equal(t, c.store, s, c.name)
+	}
+}
@@ -9,7 +9,8 @@ import (
 )
 
 type Certifier struct {
-	Crt string // mock certificate contents
+	Crt     string                     // mock certificate contents
+	Trusted []trust.TrustedCertificate // mock certificates found in the stores
 }
 
 func (c Certifier) Certificate() ([]byte, error) {
@@ -36,3 +37,16 @@ func (c Certifier) StoreCertificate(file string, info trust.Informer) error {
 func (c Certifier) Instructions() string {
 	return "Manual OS-specific instructions for certificate import"
 }
+
+func (c Certifier) TrustedCertificates() ([]trust.TrustedCertificate, error) {
+	return c.Trusted, nil
+}
+
+func (c Certifier) RemoveCertificate(domain string, info trust.Informer) error {
+	for _, t := range c.Trusted {
+		if t.Domain == domain {
+			return nil
+		}
+	}
+	return errors.New("Certificate to remove not found")
+}
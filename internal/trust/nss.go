@@ -0,0 +1,155 @@
+// +build !windows
+
+package trust
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/cli"
+	"github.com/pkg/errors"
+)
+
+// nssDBGlobs are the locations of the NSS databases of Chrome (Linux) and Firefox, relative to the home folder of the user.
+var nssDBGlobs = []string{
+	".pki/nssdb",
+	".mozilla/firefox/*",
+	"snap/firefox/common/.mozilla/firefox/*",
+	"Library/Application Support/Firefox/Profiles/*",
+}
+
+// storeNSS imports the certificate into all NSS databases of the current user using the certutil tool of NSS.
+func storeNSS(file, domain string, i Informer) error {
+	if _, err := exec.LookPath("certutil"); err != nil {
+		return errors.New("The certutil tool of NSS is required to import certificates into browsers. Install the NSS tools package of your OS, such as 'libnss3-tools', 'nss-tools', or 'nss'")
+	}
+
+	dbs, err := nssDatabases()
+	if err != nil {
+		return err
+	}
+	if len(dbs) == 0 {
+		return errors.New("Could not find any NSS database. Start your browser at least once to create it")
+	}
+
+	name := certName(domain)
+	for _, db := range dbs {
+		// remove an older certificate of the same domain first, certutil would add a duplicate otherwise
+		_, _ = cli.RunCmd("certutil", "-D", "-d", db, "-n", name)
+		if _, err := cli.RunCmd("certutil", "-A", "-d", db, "-t", "C,,", "-n", name, "-i", file); err != nil {
+			return err
+		}
+		i.LogInfof("Kyma root certificate imported into the NSS database '%s'", db)
+	}
+	return nil
+}
+
+// nssDatabases finds the NSS databases of the current user and returns them in the format expected by certutil.
+func nssDatabases() ([]string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	var dbs []string
+	for _, g := range nssDBGlobs {
+		dirs, err := filepath.Glob(filepath.Join(home, g))
+		if err != nil {
+			return nil, err
+		}
+		for _, dir := range dirs {
+			if db := nssDatabase(dir); db != "" {
+				dbs = append(dbs, db)
+			}
+		}
+	}
+	return dbs, nil
+}
+
+// nssDatabase returns the database in the given folder prefixed with its type, or an empty string if there is none.
+func nssDatabase(dir string) string {
+	if _, err := os.Stat(filepath.Join(dir, "cert9.db")); err == nil {
+		return "sql:" + dir
+	}
+	if _, err := os.Stat(filepath.Join(dir, "cert8.db")); err == nil {
+		return "dbm:" + dir
+	}
+	return ""
+}
+
+// listNSS lists the Kyma certificates of all NSS databases of the current user.
+func listNSS() ([]TrustedCertificate, error) {
+	// without the NSS tools there is no way to read the databases, but there can't be any certificates imported by Kyma CLI either
+	if _, err := exec.LookPath("certutil"); err != nil {
+		return nil, nil
+	}
+
+	dbs, err := nssDatabases()
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []TrustedCertificate
+	for _, db := range dbs {
+		names, err := nssCertNames(db)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			out, err := cli.RunCmd("certutil", "-L", "-d", db, "-n", name, "-a")
+			if err != nil {
+				return nil, err
+			}
+			cert, err := ParseCertificate([]byte(out))
+			if err != nil {
+				return nil, err
+			}
+			certs = append(certs, TrustedCertificate{
+				Domain:   strings.TrimPrefix(name, certName("")),
+				Store:    NSSStore,
+				Location: db,
+				Cert:     cert,
+			})
+		}
+	}
+	return certs, nil
+}
+
+// removeNSS removes the certificate of the given domain from all NSS databases of the current user containing it.
+func removeNSS(domain string, i Informer) error {
+	certs, err := listNSS()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range certs {
+		if c.Domain != domain {
+			continue
+		}
+		if _, err := cli.RunCmd("certutil", "-D", "-d", c.Location, "-n", certName(domain)); err != nil {
+			return err
+		}
+		i.LogInfof("Kyma root certificate removed from the NSS database '%s'", c.Location)
+	}
+	return nil
+}
+
+// nssCertNames lists the nicknames of the Kyma certificates in the given NSS database.
+func nssCertNames(db string) ([]string, error) {
+	out, err := cli.RunCmd("certutil", "-L", "-d", db)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		// certificates are listed as lines of nickname and trust attributes, such as 'kyma-kyma.local    C,,'
+		if len(fields) == 2 && strings.HasPrefix(fields[0], certName("")) {
+			names = append(names, fields[0])
+		}
+	}
+	return names, nil
+}
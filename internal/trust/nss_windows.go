@@ -0,0 +1,20 @@
+// +build windows
+
+package trust
+
+import "github.com/pkg/errors"
+
+// storeNSS is not supported on Windows, where the certutil tool of NSS clashes with the one of the OS.
+func storeNSS(file, domain string, i Informer) error {
+	return errors.New("Importing certificates into NSS databases is not supported on Windows. Import the certificate in the settings of your browser instead")
+}
+
+// listNSS lists no certificates on Windows, where importing into NSS databases is not supported.
+func listNSS() ([]TrustedCertificate, error) {
+	return nil, nil
+}
+
+// removeNSS is not supported on Windows, where the certutil tool of NSS clashes with the one of the OS.
+func removeNSS(domain string, i Informer) error {
+	return errors.New("Removing certificates from NSS databases is not supported on Windows. Remove the certificate in the settings of your browser instead")
+}
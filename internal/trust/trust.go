@@ -1,6 +1,15 @@
 // package trust provides trusted certificate management.
 package trust
 
+import (
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // Certifier defines the contract to manage digital certificates in Kyma CLI.
 type Certifier interface {
 
@@ -13,6 +22,24 @@ type Certifier interface {
 	// Instructions provides instructions on how to manually store a certificate.
 	// Use in case it can not be stored by calling StoreCertificate.
 	Instructions() string
+
+	// TrustedCertificates lists the Kyma root certificates found in the stores of the Certifier.
+	TrustedCertificates() ([]TrustedCertificate, error)
+
+	// RemoveCertificate removes the Kyma root certificate of the given domain from the stores of the Certifier.
+	RemoveCertificate(domain string, info Informer) error
+}
+
+// TrustedCertificate is a Kyma root certificate found in one of the certificate stores.
+type TrustedCertificate struct {
+	// Domain is the domain of the Kyma cluster the certificate was issued for.
+	Domain string
+	// Store is the store containing the certificate.
+	Store Store
+	// Location tells where exactly the certificate is kept, such as a file, a database or a keychain.
+	Location string
+	// Cert is the certificate itself.
+	Cert *x509.Certificate
 }
 
 // informer defines the way certification management informs about its progress.
@@ -20,3 +47,154 @@ type Informer interface {
 	LogInfo(msg string)
 	LogInfof(format string, args ...interface{})
 }
+
+// Store identifies a place into which certificates can be imported.
+type Store string
+
+const (
+	// SystemStore is the trusted root certificate store of the OS. Importing into it requires root privileges.
+	SystemStore Store = "system"
+	// NSSStore are the NSS databases of the current user, which are used by browsers such as Firefox and Chrome.
+	NSSStore Store = "nss"
+	// UserStore is a certificate bundle in the Kyma CLI home folder, which does not require root privileges.
+	UserStore Store = "user"
+)
+
+// Stores lists all supported stores.
+var Stores = []Store{SystemStore, NSSStore, UserStore}
+
+// ParseStores converts the given store names into stores and fails for any unknown store.
+func ParseStores(names []string) ([]Store, error) {
+	stores := make([]Store, 0, len(names))
+	for _, n := range names {
+		s := Store(strings.ToLower(strings.TrimSpace(n)))
+		if !s.valid() {
+			return nil, fmt.Errorf("Unknown certificate store '%s'. Supported stores are: %s", n, strings.Join(StoreNames(), ", "))
+		}
+		stores = append(stores, s)
+	}
+	return stores, nil
+}
+
+// StoreNames lists the names of all supported stores.
+func StoreNames() []string {
+	names := make([]string, len(Stores))
+	for i, s := range Stores {
+		names[i] = string(s)
+	}
+	return names
+}
+
+func (s Store) valid() bool {
+	for _, known := range Stores {
+		if s == known {
+			return true
+		}
+	}
+	return false
+}
+
+// storeCertificate imports the certificate file into all the given stores.
+// The import into the system store is OS specific and therefore done by the given function.
+func storeCertificate(file string, stores []Store, i Informer, system func(file, domain string) error) error {
+	cert, err := LoadCertificate(file)
+	if err != nil {
+		return err
+	}
+	// the domain identifies the certificate in the stores, as they can hold certificates of several Kyma clusters
+	domain, err := Domain(cert)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range stores {
+		switch s {
+		case SystemStore:
+			err = system(file, domain)
+		case NSSStore:
+			err = storeNSS(file, domain, i)
+		case UserStore:
+			err = storeUser(file, domain, i)
+		default:
+			err = fmt.Errorf("Unknown certificate store '%s'", s)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Could not import the Kyma root certificate into the %s store", s)
+		}
+	}
+	return nil
+}
+
+// listCertificates lists the Kyma certificates of all the given stores.
+// Listing the system store is OS specific and therefore done by the given function.
+func listCertificates(stores []Store, system func() ([]TrustedCertificate, error)) ([]TrustedCertificate, error) {
+	var certs []TrustedCertificate
+	for _, s := range stores {
+		var found []TrustedCertificate
+		var err error
+		switch s {
+		case SystemStore:
+			found, err = system()
+		case NSSStore:
+			found, err = listNSS()
+		case UserStore:
+			found, err = listUser()
+		default:
+			err = fmt.Errorf("Unknown certificate store '%s'", s)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "Could not list the Kyma root certificates of the %s store", s)
+		}
+		certs = append(certs, found...)
+	}
+	return certs, nil
+}
+
+// ListReadable lists the Kyma certificates of the given stores one by one, so that a store which can't be read does not hide the certificates of the other ones.
+// Unreadable stores are passed to skip and left out of the returned stores.
+func ListReadable(stores []Store, skip func(s Store, err error)) ([]TrustedCertificate, []Store) {
+	var certs []TrustedCertificate
+	var readable []Store
+	for _, s := range stores {
+		found, err := NewCertifier(nil, s).TrustedCertificates()
+		if err != nil {
+			skip(s, err)
+			continue
+		}
+		certs = append(certs, found...)
+		readable = append(readable, s)
+	}
+	return certs, readable
+}
+
+// removeCertificate removes the Kyma certificate of the domain from all the given stores.
+// The removal from the system store is OS specific and therefore done by the given function.
+func removeCertificate(domain string, stores []Store, i Informer, system func(domain string) error) error {
+	for _, s := range stores {
+		var err error
+		switch s {
+		case SystemStore:
+			err = system(domain)
+		case NSSStore:
+			err = removeNSS(domain, i)
+		case UserStore:
+			err = removeUser(domain, i)
+		default:
+			err = fmt.Errorf("Unknown certificate store '%s'", s)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Could not remove the Kyma root certificate of '%s' from the %s store", domain, s)
+		}
+	}
+	return nil
+}
+
+// certName provides the name under which the certificate of the given domain is stored.
+func certName(domain string) string {
+	return fmt.Sprintf("kyma-%s", domain)
+}
+
+// certFileDomain extracts the domain from the name of a certificate file stored with certName.
+func certFileDomain(file string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), certName("")), filepath.Ext(file))
+}
@@ -15,13 +15,21 @@ import (
 	"github.com/pkg/errors"
 )
 
+const systemKeychain = "/Library/Keychains/System.keychain"
+
 type keychain struct {
-	k8s kube.KymaKube
+	k8s    kube.KymaKube
+	stores []Store
 }
 
-func NewCertifier(k kube.KymaKube) Certifier {
+// NewCertifier creates a Certifier which imports certificates into the given stores, by default into the system store.
+func NewCertifier(k kube.KymaKube, stores ...Store) Certifier {
+	if len(stores) == 0 {
+		stores = []Store{SystemStore}
+	}
 	return keychain{
-		k8s: k,
+		k8s:    k,
+		stores: stores,
 	}
 }
 
@@ -40,6 +48,12 @@ func (k keychain) Certificate() ([]byte, error) {
 }
 
 func (k keychain) StoreCertificate(file string, i Informer) error {
+	return storeCertificate(file, k.stores, i, func(file, _ string) error {
+		return k.storeSystem(file, i)
+	})
+}
+
+func (k keychain) storeSystem(file string, i Informer) error {
 	i.LogInfo("Kyma wants to add its root certificate to the keychain.")
 	if root.IsWithSudo() {
 		i.LogInfo("You're running CLI with sudo. CLI has to add the Kyma certificate to the keychain. Type 'y' to allow this action.")
@@ -49,7 +63,7 @@ func (k keychain) StoreCertificate(file string, i Informer) error {
 		}
 	}
 
-	_, err := cli.RunCmd("sudo", "security", "add-trusted-cert", "-d", "-r", "trustRoot", "-k", "/Library/Keychains/System.keychain", file)
+	_, err := cli.RunCmd("sudo", "security", "add-trusted-cert", "-d", "-r", "trustRoot", "-k", systemKeychain, file)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("\nCould not import the Kyma root certificate. Follow the instructions below to import it manually:\n-----\n%s-----\n", k.Instructions()))
 	}
@@ -57,6 +71,57 @@ func (k keychain) StoreCertificate(file string, i Informer) error {
 	return nil
 }
 
+func (k keychain) TrustedCertificates() ([]TrustedCertificate, error) {
+	return listCertificates(k.stores, listKeychain)
+}
+
+func (k keychain) RemoveCertificate(domain string, i Informer) error {
+	return removeCertificate(domain, k.stores, i, func(domain string) error {
+		certs, err := listKeychain()
+		if err != nil {
+			return err
+		}
+		for _, c := range certs {
+			if c.Domain != domain {
+				continue
+			}
+			// also removes the trust settings of the certificate
+			if _, err := cli.RunCmd("sudo", "security", "delete-certificate", "-t", "-Z", sha1Hash(c.Cert), systemKeychain); err != nil {
+				return err
+			}
+			i.LogInfof("Kyma root certificate removed from the keychain '%s'", systemKeychain)
+		}
+		return nil
+	})
+}
+
+// listKeychain lists the Kyma certificates of the system keychain.
+// The keychain does not keep the names of imported certificate files, so they are identified by their content.
+func listKeychain() ([]TrustedCertificate, error) {
+	out, err := cli.RunCmd("security", "find-certificate", "-a", "-p", systemKeychain)
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []TrustedCertificate
+	for _, cert := range ParseCertificates([]byte(out)) {
+		if !isKymaCertificate(cert) {
+			continue
+		}
+		domain, err := Domain(cert)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, TrustedCertificate{
+			Domain:   domain,
+			Store:    SystemStore,
+			Location: systemKeychain,
+			Cert:     cert,
+		})
+	}
+	return certs, nil
+}
+
 func (keychain) Instructions() string {
 	return "1. Download the certificate: kubectl get configmap net-global-overrides -n kyma-installer -o jsonpath='{.data.global\\.ingress\\.tlsCrt}' | base64 --decode > kyma.crt\n" +
 		"2. Import the certificate: sudo security add-trusted-cert -d -r trustRoot -k /Library/Keychains/System.keychain kyma.crt\n"
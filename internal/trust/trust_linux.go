@@ -5,24 +5,29 @@ package trust
 import (
 	"encoding/base64"
 	"fmt"
-	"regexp"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/internal/kube"
 	"github.com/kyma-project/cli/internal/root"
 )
 
 type certauth struct {
-	k8s kube.KymaKube
+	k8s    kube.KymaKube
+	stores []Store
 }
 
-func NewCertifier(k kube.KymaKube) Certifier {
+// NewCertifier creates a Certifier which imports certificates into the given stores, by default into the system store.
+func NewCertifier(k kube.KymaKube, stores ...Store) Certifier {
+	if len(stores) == 0 {
+		stores = []Store{SystemStore}
+	}
 	return certauth{
-		k8s: k,
+		k8s:    k,
+		stores: stores,
 	}
 }
 
@@ -41,6 +46,12 @@ func (c certauth) Certificate() ([]byte, error) {
 }
 
 func (c certauth) StoreCertificate(file string, i Informer) error {
+	return storeCertificate(file, c.stores, i, func(file, domain string) error {
+		return c.storeSystem(file, domain, i)
+	})
+}
+
+func (c certauth) storeSystem(file, domain string, i Informer) error {
 	i.LogInfo("Kyma wants to add its root certificate to the trusted certificate store.")
 	if root.IsWithSudo() {
 		i.LogInfo("You're running CLI with sudo. CLI has to add the Kyma certificate to the trusted certificate store. Type 'y' to allow this action.")
@@ -50,44 +61,60 @@ func (c certauth) StoreCertificate(file string, i Informer) error {
 		}
 	}
 
-	// get domain to put on the certificate name.
-	// Linux does not have a proper certificate manager and we need to be able to identify the certificate
-	domain, err := certDomain(file)
+	s, err := systemCAStore()
 	if err != nil {
-		return err
+		return errors.Wrap(err, fmt.Sprintf("\nCould not import the Kyma certificates. Follow the instructions to import them manually:\n-----\n%s-----\n", c.Instructions()))
 	}
 
-	_, err = cli.RunCmd("sudo", "cp", file, fmt.Sprintf("/usr/local/share/ca-certificates/kyma-%s.crt", domain))
-	if err != nil {
+	// Linux does not have a proper certificate manager and we need to be able to identify the certificate by its domain
+	if _, err = runPrivileged("cp", file, s.certPath(domain)); err != nil {
 		return errors.Wrap(err, fmt.Sprintf("\nCould not import the Kyma certificates. Follow the instructions to import them manually:\n-----\n%s-----\n", c.Instructions()))
 	}
-	_, err = cli.RunCmd("sudo", "update-ca-certificates")
-	if err != nil {
+	if _, err = runPrivileged(s.update[0], s.update[1:]...); err != nil {
 		return errors.Wrap(err, fmt.Sprintf("\nCould not import the Kyma certificates. Follow the instructions to import them manually:\n-----\n%s-----\n", c.Instructions()))
 	}
 
 	return nil
 }
 
-func (certauth) Instructions() string {
-	return "1. Download the certificate: kubectl get configmap net-global-overrides -n kyma-installer -o jsonpath='{.data.global\\.ingress\\.tlsCrt}' | base64 --decode > kyma.crt\n" +
-		"2. Rename the certificate file: mv kyma.crt {NEW_CERT_NAME}\n" +
-		"3. Copy the certificate to the CA folder: sudo cp {NEW_CERT_NAME} /usr/local/share/ca-certificates/\n" +
-		"4. Update the certificate registry: sudo update-ca-certificates\n"
+func (c certauth) TrustedCertificates() ([]TrustedCertificate, error) {
+	return listCertificates(c.stores, func() ([]TrustedCertificate, error) {
+		s, err := systemCAStore()
+		if err != nil {
+			return nil, err
+		}
+		return listCertFiles(SystemStore, s.dir)
+	})
 }
 
-// certDomain returns the DNS info of the provided root certificate.
-func certDomain(certFile string) (string, error) {
-	certText, err := cli.RunCmd("openssl", "x509", "-text", "-noout", "-in", certFile)
-	if err != nil {
-		return "", err
-	}
+func (c certauth) RemoveCertificate(domain string, i Informer) error {
+	return removeCertificate(domain, c.stores, i, func(domain string) error {
+		s, err := systemCAStore()
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(s.certPath(domain)); os.IsNotExist(err) {
+			return nil
+		}
 
-	matches := regexp.MustCompile("DNS:(.*)[\r\n]+").FindStringSubmatch(certText)
+		if _, err := runPrivileged("rm", "-f", s.certPath(domain)); err != nil {
+			return err
+		}
+		if _, err := runPrivileged(s.update[0], s.update[1:]...); err != nil {
+			return err
+		}
+		i.LogInfof("Kyma root certificate removed from '%s'", s.dir)
+		return nil
+	})
+}
 
-	if len(matches) < 2 {
-		return "", errors.New("Could not determine the certificate's DNS")
+func (certauth) Instructions() string {
+	s, err := systemCAStore()
+	if err != nil {
+		s = debianStore
 	}
-
-	return strings.Replace(matches[1], "'", "", -1), nil
+	return "1. Download the certificate: kubectl get configmap net-global-overrides -n kyma-installer -o jsonpath='{.data.global\\.ingress\\.tlsCrt}' | base64 --decode > kyma.crt\n" +
+		"2. Rename the certificate file: mv kyma.crt {NEW_CERT_NAME}.crt\n" +
+		fmt.Sprintf("3. Copy the certificate to the CA folder: sudo cp {NEW_CERT_NAME}.crt %s/\n", s.dir) +
+		fmt.Sprintf("4. Update the certificate registry: sudo %s\n", strings.Join(s.update, " "))
 }
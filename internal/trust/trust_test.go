@@ -0,0 +1,16 @@
+package trust
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStores(t *testing.T) {
+	stores, err := ParseStores([]string{"system", " NSS", "user"})
+	require.NoError(t, err, "Parsing supported stores should not fail.")
+	require.Equal(t, []Store{SystemStore, NSSStore, UserStore}, stores)
+
+	_, err = ParseStores([]string{"system", "keychain"})
+	require.Error(t, err, "Parsing an unknown store should fail.")
+}
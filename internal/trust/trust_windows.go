@@ -5,6 +5,7 @@ package trust
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/kyma-project/cli/internal/cli"
 	"github.com/kyma-project/cli/internal/kube"
@@ -14,12 +15,18 @@ import (
 )
 
 type certutil struct {
-	k8s kube.KymaKube
+	k8s    kube.KymaKube
+	stores []Store
 }
 
-func NewCertifier(k kube.KymaKube) Certifier {
+// NewCertifier creates a Certifier which imports certificates into the given stores, by default into the system store.
+func NewCertifier(k kube.KymaKube, stores ...Store) Certifier {
+	if len(stores) == 0 {
+		stores = []Store{SystemStore}
+	}
 	return certutil{
-		k8s: k,
+		k8s:    k,
+		stores: stores,
 	}
 }
 
@@ -38,6 +45,12 @@ func (c certutil) Certificate() ([]byte, error) {
 }
 
 func (c certutil) StoreCertificate(file string, i Informer) error {
+	return storeCertificate(file, c.stores, i, func(file, _ string) error {
+		return c.storeSystem(file, i)
+	})
+}
+
+func (c certutil) storeSystem(file string, i Informer) error {
 	i.LogInfo("Kyma wants to add its root certificate to the trusted certificates.")
 
 	if root.IsWithSudo() {
@@ -53,6 +66,65 @@ func (c certutil) StoreCertificate(file string, i Informer) error {
 	return errors.New(fmt.Sprintf("Could not import the Kyma root certificate. Follow the instructions to import them manually:\n-----\n%s-----\n", c.Instructions()))
 }
 
+func (c certutil) TrustedCertificates() ([]TrustedCertificate, error) {
+	return listCertificates(c.stores, listRootStore)
+}
+
+func (c certutil) RemoveCertificate(domain string, i Informer) error {
+	return removeCertificate(domain, c.stores, i, func(domain string) error {
+		certs, err := listRootStore()
+		if err != nil {
+			return err
+		}
+		for _, crt := range certs {
+			if crt.Domain != domain {
+				continue
+			}
+			// Only remove the cert if already on admin mode, can't ask for admin password from go
+			if !root.IsWithSudo() {
+				return errors.New("Removing the Kyma root certificate requires a terminal window with administrator rights")
+			}
+			if _, err := cli.RunCmd("certutil", "-delstore", "Root", sha1Hash(crt.Cert)); err != nil {
+				return err
+			}
+			i.LogInfo("Kyma root certificate removed from the trusted certificates.")
+		}
+		return nil
+	})
+}
+
+// listRootStore lists the Kyma certificates of the trusted root certificates of the machine.
+// The store does not keep the names of imported certificate files, so they are identified by their content.
+func listRootStore() ([]TrustedCertificate, error) {
+	out, err := cli.RunCmd("powershell", "-NoProfile", "-Command", "Get-ChildItem Cert:\\LocalMachine\\Root | ForEach-Object { [Convert]::ToBase64String($_.RawData) }")
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []TrustedCertificate
+	for _, line := range strings.Fields(out) {
+		der, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			continue
+		}
+		cert, err := ParseCertificate(der)
+		if err != nil || !isKymaCertificate(cert) {
+			continue
+		}
+		domain, err := Domain(cert)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, TrustedCertificate{
+			Domain:   domain,
+			Store:    SystemStore,
+			Location: "Cert:\\LocalMachine\\Root",
+			Cert:     cert,
+		})
+	}
+	return certs, nil
+}
+
 func (certutil) Instructions() string {
 	return "1. Open a terminal window with administrator rights.\n" +
 		"2. Download the certificate: kubectl get configmap net-global-overrides -n kyma-installer -o jsonpath='{.data.global\\.ingress\\.tlsCrt}' > tmp.txt\n" +
@@ -0,0 +1,140 @@
+package trust
+
+import (
+	"bytes"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kyma-project/cli/internal/files"
+	"github.com/pkg/errors"
+)
+
+const (
+	userStoreDir = "certs"
+	// userBundle must not match the name pattern of the certificates, otherwise the bundle would be read back into itself
+	userBundle = "ca-bundle.pem"
+)
+
+// storeUser copies the certificate into the user store and regenerates the bundle of all Kyma certificates in it.
+func storeUser(file, domain string, i Informer) error {
+	cert, err := LoadCertificate(file)
+	if err != nil {
+		return err
+	}
+	dir, err := userStorePath()
+	if err != nil {
+		return err
+	}
+
+	bundle, err := addUserCert(dir, domain, cert)
+	if err != nil {
+		return err
+	}
+	// the bundle only holds Kyma certificates, so it can only be used by tools which trust it in addition to the system roots
+	i.LogInfof("Kyma root certificate added to the bundle '%s'. It only contains Kyma certificates, so use it for additional CAs, for example with: export NODE_EXTRA_CA_CERTS=%s", bundle, bundle)
+	return nil
+}
+
+// addUserCert stores the certificate of the domain in the given user store folder and regenerates the bundle.
+func addUserCert(dir, domain string, cert *x509.Certificate) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	file := filepath.Join(dir, certName(domain)+".crt")
+	if err := ioutil.WriteFile(file, EncodePEM(cert), 0644); err != nil {
+		return "", errors.Wrapf(err, "Could not write the certificate '%s'", file)
+	}
+	return updateUserBundle(dir)
+}
+
+// userStorePath returns the absolute path of the user store.
+func userStorePath() (string, error) {
+	home, err := files.KymaHome()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, userStoreDir), nil
+}
+
+// updateUserBundle concatenates all Kyma certificates of the user store folder into the bundle file and returns its path.
+func updateUserBundle(dir string) (string, error) {
+	crts, err := filepath.Glob(filepath.Join(dir, certName("*")+".crt"))
+	if err != nil {
+		return "", err
+	}
+	bundle := &bytes.Buffer{}
+	for _, crt := range crts {
+		content, err := ioutil.ReadFile(crt)
+		if err != nil {
+			return "", errors.Wrapf(err, "Could not read the certificate '%s'", crt)
+		}
+		bundle.WriteString(strings.TrimSpace(string(content)) + "\n")
+	}
+
+	path := filepath.Join(dir, userBundle)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(path, bundle.Bytes(), 0644); err != nil {
+		return "", errors.Wrapf(err, "Could not write the certificate bundle '%s'", path)
+	}
+	return path, nil
+}
+
+// listUser lists the Kyma certificates of the user store.
+func listUser() ([]TrustedCertificate, error) {
+	dir, err := userStorePath()
+	if err != nil {
+		return nil, err
+	}
+	return listCertFiles(UserStore, dir)
+}
+
+// removeUser removes the certificate of the given domain from the user store and regenerates the bundle.
+func removeUser(domain string, i Informer) error {
+	dir, err := userStorePath()
+	if err != nil {
+		return err
+	}
+
+	file := filepath.Join(dir, certName(domain)+".crt")
+	if err := os.Remove(file); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	bundle, err := updateUserBundle(dir)
+	if err != nil {
+		return err
+	}
+	i.LogInfof("Kyma root certificate removed from the bundle '%s'", bundle)
+	return nil
+}
+
+// listCertFiles lists all Kyma certificates stored as files in the given folder.
+func listCertFiles(store Store, dir string) ([]TrustedCertificate, error) {
+	files, err := filepath.Glob(filepath.Join(dir, certName("*")+".crt"))
+	if err != nil {
+		return nil, err
+	}
+
+	var certs []TrustedCertificate
+	for _, f := range files {
+		cert, err := LoadCertificate(f)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, TrustedCertificate{
+			Domain:   certFileDomain(f),
+			Store:    store,
+			Location: f,
+			Cert:     cert,
+		})
+	}
+	return certs, nil
+}
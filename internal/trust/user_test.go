@@ -0,0 +1,60 @@
+package trust
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddUserCert(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kyma-trust")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	first, err := x509.ParseCertificate(fixCertificate(t, "kyma.local", "*.kyma.local"))
+	require.NoError(t, err)
+	second, err := x509.ParseCertificate(fixCertificate(t, "kyma.example.com", "*.kyma.example.com"))
+	require.NoError(t, err)
+
+	// importing the same certificates repeatedly must not duplicate them in the bundle
+	var bundle string
+	for i := 0; i < 2; i++ {
+		bundle, err = addUserCert(dir, "kyma.local", first)
+		require.NoError(t, err)
+		bundle, err = addUserCert(dir, "kyma.example.com", second)
+		require.NoError(t, err)
+	}
+
+	content, err := ioutil.ReadFile(bundle)
+	require.NoError(t, err)
+	var fingerprints []string
+	for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		fingerprints = append(fingerprints, Fingerprint(cert))
+	}
+	require.ElementsMatch(t, []string{Fingerprint(first), Fingerprint(second)}, fingerprints, "Each certificate must be in the bundle exactly once.")
+}
+
+func TestListCertFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kyma-trust")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cert, err := x509.ParseCertificate(fixCertificate(t, "kyma.local", "*.kyma.local"))
+	require.NoError(t, err)
+	_, err = addUserCert(dir, "kyma.local", cert)
+	require.NoError(t, err)
+	// the bundle sits next to the certificates but must not be listed as one
+	require.FileExists(t, filepath.Join(dir, userBundle))
+
+	certs, err := listCertFiles(UserStore, dir)
+	require.NoError(t, err)
+	require.Len(t, certs, 1)
+	require.Equal(t, "kyma.local", certs[0].Domain)
+}
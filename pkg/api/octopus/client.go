@@ -1,24 +1,45 @@
 package octopus
 
 import (
+	"context"
 	"time"
 
 	"github.com/kyma-incubator/octopus/pkg/apis"
 	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 )
 
+const (
+	testDefinitionsResource = "testdefinitions"
+	testSuitesResource      = "clustertestsuites"
+)
+
+// Interface provides access to the Octopus testing resources.
+// All requests are aborted as soon as the given context is done.
 type Interface interface {
-	ListTestDefinitions(opts metav1.ListOptions) (result *oct.TestDefinitionList, err error)
-	ListTestSuites(opts metav1.ListOptions) (result *oct.ClusterTestSuiteList, err error)
-	CreateTestSuite(cts *oct.ClusterTestSuite) (result *oct.ClusterTestSuite, err error)
-	DeleteTestSuite(name string, options metav1.DeleteOptions) error
-	GetTestSuite(name string, options metav1.GetOptions) (result *oct.ClusterTestSuite, err error)
-	WatchTestSuite(opts metav1.ListOptions) (watch.Interface, error)
+	// ListTestDefinitions lists the test definitions of all Namespaces
+	ListTestDefinitions(ctx context.Context, opts metav1.ListOptions) (result *oct.TestDefinitionList, err error)
+	GetTestDefinition(ctx context.Context, namespace, name string, options metav1.GetOptions) (result *oct.TestDefinition, err error)
+	CreateTestDefinition(ctx context.Context, td *oct.TestDefinition) (result *oct.TestDefinition, err error)
+	UpdateTestDefinition(ctx context.Context, td *oct.TestDefinition) (result *oct.TestDefinition, err error)
+	PatchTestDefinition(ctx context.Context, namespace, name string, pt types.PatchType, data []byte) (result *oct.TestDefinition, err error)
+	DeleteTestDefinition(ctx context.Context, namespace, name string, options metav1.DeleteOptions) error
+	// WatchTestDefinitions watches the test definitions of all Namespaces
+	WatchTestDefinitions(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+
+	ListTestSuites(ctx context.Context, opts metav1.ListOptions) (result *oct.ClusterTestSuiteList, err error)
+	GetTestSuite(ctx context.Context, name string, options metav1.GetOptions) (result *oct.ClusterTestSuite, err error)
+	CreateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (result *oct.ClusterTestSuite, err error)
+	UpdateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (result *oct.ClusterTestSuite, err error)
+	PatchTestSuite(ctx context.Context, name string, pt types.PatchType, data []byte) (result *oct.ClusterTestSuite, err error)
+	DeleteTestSuite(ctx context.Context, name string, options metav1.DeleteOptions) error
+	WatchTestSuite(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
 }
 
 type RestClient struct {
@@ -42,50 +63,107 @@ func NewFromConfig(config *rest.Config) (Interface, error) {
 	}, nil
 }
 
-func (t *RestClient) ListTestDefinitions(opts metav1.ListOptions) (result *oct.TestDefinitionList, err error) {
+func (t *RestClient) ListTestDefinitions(ctx context.Context, opts metav1.ListOptions) (result *oct.TestDefinitionList, err error) {
 	result = &oct.TestDefinitionList{}
 	err = t.restClient.Get().
-		Resource("testdefinitions").
+		Context(ctx).
+		Resource(testDefinitionsResource).
 		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(listTimeout(opts)).
 		Do().
 		Into(result)
 	return
 }
 
-func (t *RestClient) ListTestSuites(opts metav1.ListOptions) (result *oct.ClusterTestSuiteList, err error) {
-	result = &oct.ClusterTestSuiteList{}
+func (t *RestClient) GetTestDefinition(ctx context.Context, namespace, name string, options metav1.GetOptions) (result *oct.TestDefinition, err error) {
+	result = &oct.TestDefinition{}
 	err = t.restClient.Get().
-		Resource("clustertestsuites").
-		VersionedParams(&opts, scheme.ParameterCodec).
+		Context(ctx).
+		Namespace(namespace).
+		Resource(testDefinitionsResource).
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
 		Do().
 		Into(result)
 	return
 }
 
-func (t *RestClient) CreateTestSuite(cts *oct.ClusterTestSuite) (result *oct.ClusterTestSuite, err error) {
-	result = &oct.ClusterTestSuite{}
+func (t *RestClient) CreateTestDefinition(ctx context.Context, td *oct.TestDefinition) (result *oct.TestDefinition, err error) {
+	result = &oct.TestDefinition{}
 	err = t.restClient.Post().
-		Resource("clustertestsuites").
-		Body(cts).
+		Context(ctx).
+		Namespace(td.Namespace).
+		Resource(testDefinitionsResource).
+		Body(td).
+		Do().
+		Into(result)
+	return
+}
+
+func (t *RestClient) UpdateTestDefinition(ctx context.Context, td *oct.TestDefinition) (result *oct.TestDefinition, err error) {
+	result = &oct.TestDefinition{}
+	err = t.restClient.Put().
+		Context(ctx).
+		Namespace(td.Namespace).
+		Resource(testDefinitionsResource).
+		Name(td.Name).
+		Body(td).
+		Do().
+		Into(result)
+	return
+}
+
+func (t *RestClient) PatchTestDefinition(ctx context.Context, namespace, name string, pt types.PatchType, data []byte) (result *oct.TestDefinition, err error) {
+	result = &oct.TestDefinition{}
+	err = t.restClient.Patch(pt).
+		Context(ctx).
+		Namespace(namespace).
+		Resource(testDefinitionsResource).
+		Name(name).
+		Body(data).
 		Do().
 		Into(result)
 	return
 }
 
-func (t *RestClient) DeleteTestSuite(name string, options metav1.DeleteOptions) error {
+func (t *RestClient) DeleteTestDefinition(ctx context.Context, namespace, name string, options metav1.DeleteOptions) error {
 	return t.restClient.Delete().
-		Resource("clustertestsuites").
+		Context(ctx).
+		Namespace(namespace).
+		Resource(testDefinitionsResource).
 		Name(name).
-		// Reenable this when deleting supports options
-		//Body(options).
+		Body(&options).
 		Do().
 		Error()
 }
 
-func (t *RestClient) GetTestSuite(name string, options metav1.GetOptions) (result *oct.ClusterTestSuite, err error) {
+func (t *RestClient) WatchTestDefinitions(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	opts.Watch = true
+	return t.restClient.Get().
+		Context(ctx).
+		Resource(testDefinitionsResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(listTimeout(opts)).
+		Watch()
+}
+
+func (t *RestClient) ListTestSuites(ctx context.Context, opts metav1.ListOptions) (result *oct.ClusterTestSuiteList, err error) {
+	result = &oct.ClusterTestSuiteList{}
+	err = t.restClient.Get().
+		Context(ctx).
+		Resource(testSuitesResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(listTimeout(opts)).
+		Do().
+		Into(result)
+	return
+}
+
+func (t *RestClient) GetTestSuite(ctx context.Context, name string, options metav1.GetOptions) (result *oct.ClusterTestSuite, err error) {
 	result = &oct.ClusterTestSuite{}
 	err = t.restClient.Get().
-		Resource("clustertestsuites").
+		Context(ctx).
+		Resource(testSuitesResource).
 		Name(name).
 		VersionedParams(&options, scheme.ParameterCodec).
 		Do().
@@ -93,19 +171,106 @@ func (t *RestClient) GetTestSuite(name string, options metav1.GetOptions) (resul
 	return
 }
 
-func (t *RestClient) WatchTestSuite(opts metav1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
+func (t *RestClient) CreateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (result *oct.ClusterTestSuite, err error) {
+	result = &oct.ClusterTestSuite{}
+	err = t.restClient.Post().
+		Context(ctx).
+		Resource(testSuitesResource).
+		Body(cts).
+		Do().
+		Into(result)
+	return
+}
+
+func (t *RestClient) UpdateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (result *oct.ClusterTestSuite, err error) {
+	result = &oct.ClusterTestSuite{}
+	err = t.restClient.Put().
+		Context(ctx).
+		Resource(testSuitesResource).
+		Name(cts.Name).
+		Body(cts).
+		Do().
+		Into(result)
+	return
+}
+
+func (t *RestClient) PatchTestSuite(ctx context.Context, name string, pt types.PatchType, data []byte) (result *oct.ClusterTestSuite, err error) {
+	result = &oct.ClusterTestSuite{}
+	err = t.restClient.Patch(pt).
+		Context(ctx).
+		Resource(testSuitesResource).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
+
+func (t *RestClient) DeleteTestSuite(ctx context.Context, name string, options metav1.DeleteOptions) error {
+	return t.restClient.Delete().
+		Context(ctx).
+		Resource(testSuitesResource).
+		Name(name).
+		Body(&options).
+		Do().
+		Error()
+}
+
+func (t *RestClient) WatchTestSuite(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
 	opts.Watch = true
 	return t.restClient.Get().
-		Resource("clustertestsuites").
+		Context(ctx).
+		Resource(testSuitesResource).
 		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
+		Timeout(listTimeout(opts)).
 		Watch()
 }
 
+// ListAllTestSuites lists the test suites in pages of the given size, so that long lists do not hit request timeouts,
+// and returns all of them at once. A page size of 0 lists everything with a single request.
+func ListAllTestSuites(ctx context.Context, cli Interface, opts metav1.ListOptions, pageSize int64) (*oct.ClusterTestSuiteList, error) {
+	opts.Limit = pageSize
+	result := &oct.ClusterTestSuiteList{}
+	for {
+		page, err := cli.ListTestSuites(ctx, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing test suites")
+		}
+		result.Items = append(result.Items, page.Items...)
+		result.ResourceVersion = page.ResourceVersion
+		if page.Continue == "" {
+			return result, nil
+		}
+		opts.Continue = page.Continue
+	}
+}
+
+// ListAllTestDefinitions lists the test definitions in pages of the given size, so that long lists do not hit request timeouts,
+// and returns all of them at once. A page size of 0 lists everything with a single request.
+func ListAllTestDefinitions(ctx context.Context, cli Interface, opts metav1.ListOptions, pageSize int64) (*oct.TestDefinitionList, error) {
+	opts.Limit = pageSize
+	result := &oct.TestDefinitionList{}
+	for {
+		page, err := cli.ListTestDefinitions(ctx, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing test definitions")
+		}
+		result.Items = append(result.Items, page.Items...)
+		result.ResourceVersion = page.ResourceVersion
+		if page.Continue == "" {
+			return result, nil
+		}
+		opts.Continue = page.Continue
+	}
+}
+
+func listTimeout(opts metav1.ListOptions) time.Duration {
+	if opts.TimeoutSeconds == nil {
+		return 0
+	}
+	return time.Duration(*opts.TimeoutSeconds) * time.Second
+}
+
 func setConfigDefaults(config *rest.Config) {
 	gv := oct.SchemeGroupVersion
 	config.GroupVersion = &gv
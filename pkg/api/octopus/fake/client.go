@@ -0,0 +1,532 @@
+// Package fake provides an in-memory implementation of the octopus.Interface for testing.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/testing"
+)
+
+var (
+	testDefinitionsResource = oct.SchemeGroupVersion.WithResource("testdefinitions")
+	testSuitesResource      = oct.SchemeGroupVersion.WithResource("clustertestsuites")
+
+	testDefinitionKind = oct.SchemeGroupVersion.WithKind("TestDefinition")
+	testSuiteKind      = oct.SchemeGroupVersion.WithKind("ClusterTestSuite")
+)
+
+// Client is an octopus.Interface which keeps the test definitions and test suites in memory.
+// Changes done through the client are delivered to all open watches, so that it can be used to test commands
+// which wait for test suites.
+type Client struct {
+	tracker testing.ObjectTracker
+
+	// mu serializes all write operations, so that the resource versions are checked and assigned consistently
+	mu              sync.Mutex
+	resourceVersion int
+
+	// watches counts the watches opened so far
+	watches int32
+}
+
+var _ octopus.Interface = &Client{}
+
+// NewSimpleClient returns a Client which already contains the given test definitions and test suites
+func NewSimpleClient(objects ...runtime.Object) *Client {
+	scheme := runtime.NewScheme()
+	if err := oct.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+
+	c := &Client{
+		tracker: testing.NewObjectTracker(scheme, serializer.NewCodecFactory(scheme).UniversalDecoder()),
+	}
+	for _, obj := range objects {
+		if meta.IsListType(obj) {
+			items, err := meta.ExtractList(obj)
+			if err != nil {
+				panic(err)
+			}
+			for _, item := range items {
+				c.add(item)
+			}
+			continue
+		}
+		c.add(obj)
+	}
+	return c
+}
+
+// add puts the object into the tracker as it is, without assigning a resource version
+func (c *Client) add(obj runtime.Object) {
+	if err := c.tracker.Add(obj); err != nil {
+		panic(err)
+	}
+}
+
+func (c *Client) ListTestDefinitions(ctx context.Context, opts metav1.ListOptions) (*oct.TestDefinitionList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.tracker.List(testDefinitionsResource, testDefinitionKind, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	list := obj.(*oct.TestDefinitionList)
+
+	items := make([]runtime.Object, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, &list.Items[i])
+	}
+	items, cont, err := c.filter(items, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &oct.TestDefinitionList{ListMeta: metav1.ListMeta{Continue: cont, ResourceVersion: c.currentResourceVersion()}}
+	for _, item := range items {
+		result.Items = append(result.Items, *item.(*oct.TestDefinition))
+	}
+	return result, nil
+}
+
+func (c *Client) GetTestDefinition(ctx context.Context, namespace, name string, options metav1.GetOptions) (*oct.TestDefinition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.tracker.Get(testDefinitionsResource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.TestDefinition), nil
+}
+
+func (c *Client) CreateTestDefinition(ctx context.Context, td *oct.TestDefinition) (*oct.TestDefinition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if td.Namespace == "" {
+		return nil, k8serrors.NewBadRequest("the namespace of the test definition is not set")
+	}
+	obj, err := c.create(testDefinitionsResource, td.DeepCopy(), td.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.TestDefinition), nil
+}
+
+func (c *Client) UpdateTestDefinition(ctx context.Context, td *oct.TestDefinition) (*oct.TestDefinition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.update(testDefinitionsResource, td.DeepCopy(), td.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.TestDefinition), nil
+}
+
+// PatchTestDefinition supports only JSON merge patches, as the API server does not support strategic merge patches for
+// custom resources.
+func (c *Client) PatchTestDefinition(ctx context.Context, namespace, name string, pt types.PatchType, data []byte) (*oct.TestDefinition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pt != types.MergePatchType {
+		return nil, k8serrors.NewBadRequest(fmt.Sprintf("patch type %q is not supported", pt))
+	}
+
+	current, err := c.GetTestDefinition(ctx, namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	patched := &oct.TestDefinition{}
+	if err := mergePatch(current, data, patched); err != nil {
+		return nil, err
+	}
+	if patched.Name != name || patched.Namespace != namespace {
+		return nil, k8serrors.NewBadRequest("the name and Namespace of the test definition cannot be changed")
+	}
+	// a merge patch without resource version must not conflict with concurrent changes
+	if patched.ResourceVersion == current.ResourceVersion {
+		patched.ResourceVersion = ""
+	}
+
+	obj, err := c.update(testDefinitionsResource, patched, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.TestDefinition), nil
+}
+
+func (c *Client) DeleteTestDefinition(ctx context.Context, namespace, name string, options metav1.DeleteOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.delete(testDefinitionsResource, namespace, name, options)
+}
+
+func (c *Client) WatchTestDefinitions(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.watch(ctx, testDefinitionsResource, opts)
+}
+
+func (c *Client) ListTestSuites(ctx context.Context, opts metav1.ListOptions) (*oct.ClusterTestSuiteList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.tracker.List(testSuitesResource, testSuiteKind, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+	list := obj.(*oct.ClusterTestSuiteList)
+
+	items := make([]runtime.Object, 0, len(list.Items))
+	for i := range list.Items {
+		items = append(items, &list.Items[i])
+	}
+	items, cont, err := c.filter(items, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &oct.ClusterTestSuiteList{ListMeta: metav1.ListMeta{Continue: cont, ResourceVersion: c.currentResourceVersion()}}
+	for _, item := range items {
+		result.Items = append(result.Items, *item.(*oct.ClusterTestSuite))
+	}
+	return result, nil
+}
+
+func (c *Client) GetTestSuite(ctx context.Context, name string, options metav1.GetOptions) (*oct.ClusterTestSuite, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.tracker.Get(testSuitesResource, metav1.NamespaceNone, name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.ClusterTestSuite), nil
+}
+
+func (c *Client) CreateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (*oct.ClusterTestSuite, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.create(testSuitesResource, cts.DeepCopy(), metav1.NamespaceNone)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.ClusterTestSuite), nil
+}
+
+func (c *Client) UpdateTestSuite(ctx context.Context, cts *oct.ClusterTestSuite) (*oct.ClusterTestSuite, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	obj, err := c.update(testSuitesResource, cts.DeepCopy(), metav1.NamespaceNone)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.ClusterTestSuite), nil
+}
+
+// PatchTestSuite supports only JSON merge patches, as the API server does not support strategic merge patches for
+// custom resources.
+func (c *Client) PatchTestSuite(ctx context.Context, name string, pt types.PatchType, data []byte) (*oct.ClusterTestSuite, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if pt != types.MergePatchType {
+		return nil, k8serrors.NewBadRequest(fmt.Sprintf("patch type %q is not supported", pt))
+	}
+
+	current, err := c.GetTestSuite(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	patched := &oct.ClusterTestSuite{}
+	if err := mergePatch(current, data, patched); err != nil {
+		return nil, err
+	}
+	if patched.Name != name {
+		return nil, k8serrors.NewBadRequest("the name of the test suite cannot be changed")
+	}
+	// a merge patch without resource version must not conflict with concurrent changes
+	if patched.ResourceVersion == current.ResourceVersion {
+		patched.ResourceVersion = ""
+	}
+
+	obj, err := c.update(testSuitesResource, patched, metav1.NamespaceNone)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*oct.ClusterTestSuite), nil
+}
+
+func (c *Client) DeleteTestSuite(ctx context.Context, name string, options metav1.DeleteOptions) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.delete(testSuitesResource, metav1.NamespaceNone, name, options)
+}
+
+func (c *Client) WatchTestSuite(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	return c.watch(ctx, testSuitesResource, opts)
+}
+
+func (c *Client) create(gvr schema.GroupVersionResource, obj runtime.Object, ns string) (runtime.Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if m.GetName() == "" && m.GetGenerateName() != "" {
+		m.SetName(m.GetGenerateName() + rand.String(5))
+	}
+	if m.GetName() == "" {
+		return nil, k8serrors.NewBadRequest("the name of the resource is not set")
+	}
+	m.SetUID(types.UID(rand.String(16)))
+	m.SetCreationTimestamp(metav1.NewTime(time.Now()))
+	m.SetResourceVersion(c.nextResourceVersion())
+
+	if err := c.tracker.Create(gvr, obj, ns); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *Client) update(gvr schema.GroupVersionResource, obj runtime.Object, ns string) (runtime.Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	current, err := c.tracker.Get(gvr, ns, m.GetName())
+	if err != nil {
+		return nil, err
+	}
+	cm, err := meta.Accessor(current)
+	if err != nil {
+		return nil, err
+	}
+	if m.GetResourceVersion() != "" && m.GetResourceVersion() != cm.GetResourceVersion() {
+		return nil, k8serrors.NewConflict(gvr.GroupResource(), m.GetName(),
+			errors.New("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+	m.SetUID(cm.GetUID())
+	m.SetCreationTimestamp(cm.GetCreationTimestamp())
+	m.SetResourceVersion(c.nextResourceVersion())
+
+	if err := c.tracker.Update(gvr, obj, ns); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (c *Client) delete(gvr schema.GroupVersionResource, ns, name string, options metav1.DeleteOptions) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p := options.Preconditions; p != nil {
+		current, err := c.tracker.Get(gvr, ns, name)
+		if err != nil {
+			return err
+		}
+		m, err := meta.Accessor(current)
+		if err != nil {
+			return err
+		}
+		if p.UID != nil && *p.UID != m.GetUID() {
+			return k8serrors.NewConflict(gvr.GroupResource(), name,
+				errors.Errorf("precondition failed: UID in precondition: %v, UID in object meta: %v", *p.UID, m.GetUID()))
+		}
+		if p.ResourceVersion != nil && *p.ResourceVersion != m.GetResourceVersion() {
+			return k8serrors.NewConflict(gvr.GroupResource(), name,
+				errors.Errorf("precondition failed: ResourceVersion in precondition: %v, ResourceVersion in object meta: %v", *p.ResourceVersion, m.GetResourceVersion()))
+		}
+	}
+	return c.tracker.Delete(gvr, ns, name)
+}
+
+// watch opens a watch on the tracker which is stopped as soon as the context is done.
+// Only events of objects matching the selectors of the options are delivered.
+func (c *Client) watch(ctx context.Context, gvr schema.GroupVersionResource, opts metav1.ListOptions) (watch.Interface, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	match, err := matcher(opts)
+	if err != nil {
+		return nil, err
+	}
+	w, err := c.tracker.Watch(gvr, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		return in, match(in.Object)
+	})
+	atomic.AddInt32(&c.watches, 1)
+	if ctx.Done() != nil {
+		go func() {
+			<-ctx.Done()
+			filtered.Stop()
+		}()
+	}
+	return filtered, nil
+}
+
+// Watches returns the number of watches opened on the client so far.
+// Tests use it to make changes only once the code under test is watching for them.
+func (c *Client) Watches() int {
+	return int(atomic.LoadInt32(&c.watches))
+}
+
+// filter returns the objects matching the selectors of the options sorted by namespace and name.
+// If a limit is given only one page is returned together with the continue token of the next page.
+func (c *Client) filter(objs []runtime.Object, opts metav1.ListOptions) ([]runtime.Object, string, error) {
+	match, err := matcher(opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var result []runtime.Object
+	for _, obj := range objs {
+		if match(obj) {
+			result = append(result, obj)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return key(result[i]) < key(result[j])
+	})
+
+	offset := 0
+	if opts.Continue != "" {
+		offset, err = strconv.Atoi(opts.Continue)
+		if err != nil || offset < 0 || offset > len(result) {
+			return nil, "", k8serrors.NewBadRequest("invalid continue token")
+		}
+	}
+	result = result[offset:]
+	if opts.Limit <= 0 || int64(len(result)) <= opts.Limit {
+		return result, "", nil
+	}
+	return result[:opts.Limit], strconv.Itoa(offset + int(opts.Limit)), nil
+}
+
+func (c *Client) nextResourceVersion() string {
+	c.resourceVersion++
+	return strconv.Itoa(c.resourceVersion)
+}
+
+func (c *Client) currentResourceVersion() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return strconv.Itoa(c.resourceVersion)
+}
+
+// matcher returns a function which checks if an object matches the label and field selectors of the options.
+// The field selector supports the metadata.name and metadata.namespace fields.
+func matcher(opts metav1.ListOptions) (func(runtime.Object) bool, error) {
+	ls := labels.Everything()
+	if opts.LabelSelector != "" {
+		var err error
+		if ls, err = labels.Parse(opts.LabelSelector); err != nil {
+			return nil, k8serrors.NewBadRequest(err.Error())
+		}
+	}
+	fs := fields.Everything()
+	if opts.FieldSelector != "" {
+		var err error
+		if fs, err = fields.ParseSelector(opts.FieldSelector); err != nil {
+			return nil, k8serrors.NewBadRequest(err.Error())
+		}
+	}
+
+	return func(obj runtime.Object) bool {
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return false
+		}
+		return ls.Matches(labels.Set(m.GetLabels())) && fs.Matches(fields.Set{
+			"metadata.name":      m.GetName(),
+			"metadata.namespace": m.GetNamespace(),
+		})
+	}, nil
+}
+
+func key(obj runtime.Object) string {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return m.GetNamespace() + "/" + m.GetName()
+}
+
+// mergePatch applies the JSON merge patch (RFC 7386) to the original object and stores the outcome in patched
+func mergePatch(original runtime.Object, patch []byte, patched runtime.Object) error {
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+	var doc, p interface{}
+	if err := json.Unmarshal(originalJSON, &doc); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return k8serrors.NewBadRequest(err.Error())
+	}
+
+	patchedJSON, err := json.Marshal(mergeJSON(doc, p))
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(patchedJSON, patched); err != nil {
+		return k8serrors.NewBadRequest(err.Error())
+	}
+	return nil
+}
+
+func mergeJSON(doc, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	d, ok := doc.(map[string]interface{})
+	if !ok {
+		d = map[string]interface{}{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(d, k)
+			continue
+		}
+		d[k] = mergeJSON(d[k], v)
+	}
+	return d
+}
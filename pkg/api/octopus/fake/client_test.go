@@ -0,0 +1,207 @@
+package fake
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	oct "github.com/kyma-incubator/octopus/pkg/apis/testing/v1alpha1"
+	"github.com/kyma-project/cli/pkg/api/octopus"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func suite(name string, labels map[string]string) *oct.ClusterTestSuite {
+	return &oct.ClusterTestSuite{
+		TypeMeta:   metav1.TypeMeta{Kind: "ClusterTestSuite", APIVersion: oct.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+	}
+}
+
+func definition(namespace, name string) *oct.TestDefinition {
+	return &oct.TestDefinition{
+		TypeMeta:   metav1.TypeMeta{Kind: "TestDefinition", APIVersion: oct.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+}
+
+func TestClient_TestSuites(t *testing.T) {
+	ctx := context.Background()
+	cli := NewSimpleClient(suite("b", nil), suite("a", map[string]string{"app": "x"}))
+
+	t.Run("list with selectors", func(t *testing.T) {
+		list, err := cli.ListTestSuites(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 2)
+		require.Equal(t, "a", list.Items[0].Name)
+
+		list, err = cli.ListTestSuites(ctx, metav1.ListOptions{LabelSelector: "app=x"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		require.Equal(t, "a", list.Items[0].Name)
+
+		list, err = cli.ListTestSuites(ctx, metav1.ListOptions{FieldSelector: "metadata.name=b"})
+		require.NoError(t, err)
+		require.Len(t, list.Items, 1)
+		require.Equal(t, "b", list.Items[0].Name)
+	})
+
+	t.Run("create with generated name", func(t *testing.T) {
+		cts := suite("", nil)
+		cts.GenerateName = "gen-"
+		created, err := cli.CreateTestSuite(ctx, cts)
+		require.NoError(t, err)
+		require.Contains(t, created.Name, "gen-")
+		require.NotEmpty(t, created.UID)
+		require.False(t, created.CreationTimestamp.IsZero())
+
+		_, err = cli.CreateTestSuite(ctx, created)
+		require.True(t, k8serrors.IsAlreadyExists(err))
+	})
+
+	t.Run("update detects conflicts", func(t *testing.T) {
+		cts, err := cli.GetTestSuite(ctx, "a", metav1.GetOptions{})
+		require.NoError(t, err)
+		cts.Spec.Count = 3
+		updated, err := cli.UpdateTestSuite(ctx, cts)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, updated.Spec.Count)
+
+		// the first update assigns a resource version, so the original object is outdated afterwards
+		_, err = cli.UpdateTestSuite(ctx, updated)
+		require.NoError(t, err)
+		_, err = cli.UpdateTestSuite(ctx, updated)
+		require.True(t, k8serrors.IsConflict(err))
+	})
+
+	t.Run("merge patch", func(t *testing.T) {
+		patched, err := cli.PatchTestSuite(ctx, "a", types.MergePatchType, []byte(`{"metadata":{"labels":{"app":null,"new":"y"}},"spec":{"maxRetries":2}}`))
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"new": "y"}, patched.Labels)
+		require.EqualValues(t, 2, patched.Spec.MaxRetries)
+		require.EqualValues(t, 3, patched.Spec.Count)
+
+		_, err = cli.PatchTestSuite(ctx, "a", types.JSONPatchType, []byte(`[]`))
+		require.True(t, k8serrors.IsBadRequest(err))
+	})
+
+	t.Run("delete honors preconditions", func(t *testing.T) {
+		uid := types.UID("other")
+		err := cli.DeleteTestSuite(ctx, "a", metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}})
+		require.True(t, k8serrors.IsConflict(err))
+
+		cts, err := cli.GetTestSuite(ctx, "a", metav1.GetOptions{})
+		require.NoError(t, err)
+		err = cli.DeleteTestSuite(ctx, "a", metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &cts.UID}})
+		require.NoError(t, err)
+
+		_, err = cli.GetTestSuite(ctx, "a", metav1.GetOptions{})
+		require.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := cli.ListTestSuites(canceled, metav1.ListOptions{})
+		require.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestClient_WatchTestSuite(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cli := NewSimpleClient()
+
+	require.Equal(t, 0, cli.Watches())
+	w, err := cli.WatchTestSuite(ctx, metav1.ListOptions{FieldSelector: "metadata.name=watched"})
+	require.NoError(t, err)
+	require.Equal(t, 1, cli.Watches())
+
+	_, err = cli.CreateTestSuite(ctx, suite("ignored", nil))
+	require.NoError(t, err)
+	cts, err := cli.CreateTestSuite(ctx, suite("watched", nil))
+	require.NoError(t, err)
+	cts.Status.Conditions = []oct.TestSuiteCondition{{Type: oct.SuiteSucceeded, Status: oct.StatusTrue}}
+	_, err = cli.UpdateTestSuite(ctx, cts)
+	require.NoError(t, err)
+
+	for _, expected := range []watch.EventType{watch.Added, watch.Modified} {
+		select {
+		case ev := <-w.ResultChan():
+			require.Equal(t, expected, ev.Type)
+			require.Equal(t, "watched", ev.Object.(*oct.ClusterTestSuite).Name)
+		case <-time.After(time.Second):
+			t.Fatalf("%s event not received", expected)
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-w.ResultChan():
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("watch not stopped after the context is done")
+	}
+}
+
+func TestClient_TestDefinitions(t *testing.T) {
+	ctx := context.Background()
+	cli := NewSimpleClient(definition("ns1", "a"), definition("ns2", "a"))
+
+	w, err := cli.WatchTestDefinitions(ctx, metav1.ListOptions{FieldSelector: "metadata.namespace=ns2"})
+	require.NoError(t, err)
+	defer w.Stop()
+
+	td, err := cli.GetTestDefinition(ctx, "ns2", "a", metav1.GetOptions{})
+	require.NoError(t, err)
+	td.Labels = map[string]string{"updated": "true"}
+	_, err = cli.UpdateTestDefinition(ctx, td)
+	require.NoError(t, err)
+
+	select {
+	case ev := <-w.ResultChan():
+		require.Equal(t, watch.Modified, ev.Type)
+	case <-time.After(time.Second):
+		t.Fatal("event not received")
+	}
+
+	patched, err := cli.PatchTestDefinition(ctx, "ns2", "a", types.MergePatchType, []byte(`{"metadata":{"labels":{"updated":null,"patched":"true"}}}`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"patched": "true"}, patched.Labels)
+	_, err = cli.PatchTestDefinition(ctx, "ns2", "a", types.MergePatchType, []byte(`{"metadata":{"namespace":"ns1"}}`))
+	require.True(t, k8serrors.IsBadRequest(err), "test definitions cannot be moved to another Namespace")
+	_, err = cli.PatchTestDefinition(ctx, "ns2", "a", types.JSONPatchType, []byte(`[]`))
+	require.True(t, k8serrors.IsBadRequest(err))
+
+	_, err = cli.CreateTestDefinition(ctx, definition("", "b"))
+	require.True(t, k8serrors.IsBadRequest(err))
+	_, err = cli.CreateTestDefinition(ctx, definition("ns1", "b"))
+	require.NoError(t, err)
+
+	require.NoError(t, cli.DeleteTestDefinition(ctx, "ns1", "a", metav1.DeleteOptions{}))
+	list, err := cli.ListTestDefinitions(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+}
+
+func TestListAll(t *testing.T) {
+	ctx := context.Background()
+	cli := NewSimpleClient(suite("a", nil), suite("b", nil), suite("c", nil), definition("ns", "a"), definition("ns", "b"))
+
+	page, err := cli.ListTestSuites(ctx, metav1.ListOptions{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	require.NotEmpty(t, page.Continue)
+
+	suites, err := octopus.ListAllTestSuites(ctx, cli, metav1.ListOptions{}, 2)
+	require.NoError(t, err)
+	require.Len(t, suites.Items, 3)
+	require.Equal(t, "c", suites.Items[2].Name)
+
+	defs, err := octopus.ListAllTestDefinitions(ctx, cli, metav1.ListOptions{}, 1)
+	require.NoError(t, err)
+	require.Len(t, defs.Items, 2)
+}
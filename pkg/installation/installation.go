@@ -2,6 +2,7 @@ package installation
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -80,7 +81,8 @@ func (i *Installation) newStep(msg string) step.Step {
 }
 
 // InstallKyma triggers the installation of a Kyma cluster.
-func (i *Installation) InstallKyma() (*Result, error) {
+// If the context is cancelled, the installation stops waiting and returns the steps that were left unfinished.
+func (i *Installation) InstallKyma(ctx context.Context) (*Result, error) {
 	if i.Options.CI || i.Options.NonInteractive {
 		i.Factory.NonInteractive = true
 	}
@@ -113,9 +115,9 @@ func (i *Installation) InstallKyma() (*Result, error) {
 	s.Successf("Installation source checked")
 
 	s = i.newStep("Installing Tiller")
-	if err := i.installTiller(); err != nil {
+	if err := i.installTiller(ctx); err != nil {
 		s.Failure()
-		return nil, err
+		return nil, interruptedErr(ctx, err, "Tiller may not be deployed completely")
 	}
 	s.Successf("Tiller deployed")
 
@@ -125,12 +127,15 @@ func (i *Installation) InstallKyma() (*Result, error) {
 		s.Failure()
 		return nil, err
 	}
+	if i.Options.Preset != "" {
+		s.LogInfof("Installing the components of the preset '%s'", i.Options.Preset)
+	}
 	s.Successf("Installation files loaded")
 
 	s = i.newStep("Deploying Kyma Installer")
-	if err := i.installInstaller(resources); err != nil {
+	if err := i.installInstaller(ctx, resources); err != nil {
 		s.Failure()
-		return nil, err
+		return nil, interruptedErr(ctx, err, "The Kyma Installer may not be deployed completely. Run the installation again to finish it")
 	}
 	s.Successf("Kyma Installer deployed")
 
@@ -171,7 +176,7 @@ func (i *Installation) InstallKyma() (*Result, error) {
 	s.Successf("Kyma Installer is installing Kyma")
 
 	if !i.Options.NoWait {
-		if err := i.waitForInstaller(); err != nil {
+		if err := i.waitForInstaller(ctx); err != nil {
 			return nil, err
 		}
 	}
@@ -245,7 +250,7 @@ func (i *Installation) validateConfigurations() error {
 	return nil
 }
 
-func (i *Installation) installTiller() error {
+func (i *Installation) installTiller(ctx context.Context) error {
 	deployed, err := i.k8s.IsPodDeployedByLabel("kube-system", "name", "tiller")
 	if err != nil {
 		return err
@@ -264,7 +269,7 @@ func (i *Installation) installTiller() error {
 			return err
 		}
 	}
-	return i.k8s.WaitPodStatusByLabel("kube-system", "name", "tiller", corev1.PodRunning)
+	return i.k8s.WaitPods(ctx, "kube-system", "name=tiller", kube.PodReady, 0)
 }
 
 func (i *Installation) prepareFiles() ([]File, error) {
@@ -285,6 +290,11 @@ func (i *Installation) prepareFiles() ([]File, error) {
 		return nil, err
 	}
 
+	err = applyPreset(Files, i.Options.Preset)
+	if err != nil {
+		return nil, err
+	}
+
 	//In case of local installation from local sources, build installer image.
 	//TODO: add image build & push functionality for remote installation from local sources.
 	if i.Options.fromLocalSources && i.Options.IsLocal {
@@ -354,7 +364,7 @@ func (i *Installation) loadInstallationResourceFiles(resourcePaths []string) ([]
 	return resFiles, nil
 }
 
-func (i *Installation) installInstaller(files []File) error {
+func (i *Installation) installInstaller(ctx context.Context, files []File) error {
 	deployed, err := i.k8s.IsPodDeployedByLabel("kyma-installer", "name", "kyma-installer")
 	if err != nil {
 		return err
@@ -379,7 +389,7 @@ func (i *Installation) installInstaller(files []File) error {
 			return err
 		}
 	}
-	return i.k8s.WaitPodStatusByLabel("kyma-installer", "name", "kyma-installer", corev1.PodRunning)
+	return i.k8s.WaitPods(ctx, "kyma-installer", "name=kyma-installer", kube.PodReady, 0)
 }
 
 func (i *Installation) applyOverrideFiles() error {
@@ -581,7 +591,7 @@ func (i *Installation) activateInstaller() error {
 	return nil
 }
 
-func (i *Installation) waitForInstaller() error {
+func (i *Installation) waitForInstaller(ctx context.Context) error {
 	currentDesc := ""
 	i.newStep("Waiting for installation to start")
 
@@ -601,6 +611,9 @@ func (i *Installation) waitForInstaller() error {
 
 	for {
 		select {
+		case <-ctx.Done():
+			i.currentStep.Failure()
+			return errors.Wrap(ctx.Err(), "Stopped waiting for the installation to complete. The Kyma Installer keeps installing Kyma in the cluster, run `kubectl get installation/kyma-installation -o jsonpath='{.status}'` to check its status")
 		case <-timeout:
 			i.currentStep.Failure()
 			if err := i.printInstallationErrorLog(); err != nil {
@@ -648,11 +661,22 @@ func (i *Installation) waitForInstaller() error {
 				i.currentStep.Failure()
 				return fmt.Errorf("unexpected status: %s", status)
 			}
-			time.Sleep(10 * time.Second)
+			select {
+			case <-ctx.Done():
+			case <-time.After(10 * time.Second):
+			}
 		}
 	}
 }
 
+// interruptedErr adds a note about what was left half-done to the given error if the context was cancelled.
+func interruptedErr(ctx context.Context, err error, note string) error {
+	if ctx.Err() == nil {
+		return err
+	}
+	return errors.Wrapf(err, "Installation interrupted. %s", note)
+}
+
 func (i *Installation) buildResult() (*Result, error) {
 	v, err := version.KymaVersion(i.Options.Verbose, i.k8s)
 	if err != nil {
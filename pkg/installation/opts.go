@@ -43,6 +43,10 @@ type Options struct {
 	// LocalCluster includes the configuration options of a local cluster.
 	// +optional
 	LocalCluster *LocalCluster `json:"localCluster,omitempty"`
+	// Preset specifies the set of components to install, matching the preset the cluster was provisioned with.
+	// By default, the components of the default Kyma installation are installed.
+	// +optional
+	Preset string `json:"preset,omitempty"`
 
 	// Timeout specifies the time-out after which watching the installation progress stops.
 	// +optional
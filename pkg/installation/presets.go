@@ -0,0 +1,101 @@
+package installation
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// PresetMinimal installs the Kyma core components and Istio, without monitoring, logging, tracing, and Knative
+	PresetMinimal = "minimal"
+	// PresetDefault installs the components of the default Kyma installation
+	PresetDefault = "default"
+	// PresetFull installs the components of the default Kyma installation and the optional components
+	PresetFull = "full"
+)
+
+// minimalExcludedComponents are the components of the default installation which the minimal preset leaves out
+var minimalExcludedComponents = map[string]bool{
+	"monitoring":                true,
+	"kiali":                     true,
+	"tracing":                   true,
+	"logging":                   true,
+	"knative-serving-init":      true,
+	"knative-serving":           true,
+	"knative-build-init":        true,
+	"knative-build":             true,
+	"knative-eventing":          true,
+	"knative-provisioner-natss": true,
+	"nats-streaming":            true,
+	"event-sources":             true,
+	"function-controller":       true,
+	"serverless":                true,
+}
+
+// fullAddedComponents are the optional components which the full preset installs in addition to the default installation
+var fullAddedComponents = []map[interface{}]interface{}{
+	{"name": "knative-provisioner-natss", "namespace": "knative-eventing"},
+	{"name": "nats-streaming", "namespace": "natss"},
+}
+
+// applyPreset adapts the components of the Installation to the preset the cluster was sized for
+func applyPreset(files []File, preset string) error {
+	switch preset {
+	case "", PresetDefault:
+		return nil
+	case PresetMinimal, PresetFull:
+	default:
+		return fmt.Errorf("Unknown preset '%s', it must be one of %s, %s, %s", preset, PresetMinimal, PresetDefault, PresetFull)
+	}
+
+	for _, f := range files {
+		for _, config := range f {
+			if kind, ok := config["kind"]; !ok || kind != "Installation" {
+				continue
+			}
+			spec, ok := config["spec"].(map[interface{}]interface{})
+			if !ok {
+				return errors.New("Installation contains no SPEC section")
+			}
+			components, ok := spec["components"].([]interface{})
+			if !ok {
+				return errors.New("Installation contains no COMPONENTS section")
+			}
+			spec["components"] = presetComponents(components, preset)
+		}
+	}
+	return nil
+}
+
+// presetComponents returns the given components without the ones left out by the preset and with the ones it adds
+func presetComponents(components []interface{}, preset string) []interface{} {
+	installed := map[string]bool{}
+	result := make([]interface{}, 0, len(components))
+	for _, c := range components {
+		name := componentName(c)
+		installed[name] = true
+		if preset == PresetMinimal && minimalExcludedComponents[name] {
+			continue
+		}
+		result = append(result, c)
+	}
+
+	if preset == PresetFull {
+		for _, c := range fullAddedComponents {
+			if !installed[componentName(c)] {
+				result = append(result, c)
+			}
+		}
+	}
+	return result
+}
+
+func componentName(c interface{}) string {
+	m, ok := c.(map[interface{}]interface{})
+	if !ok {
+		return ""
+	}
+	name, _ := m["name"].(string)
+	return name
+}
@@ -0,0 +1,57 @@
+package installation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplyPreset(t *testing.T) {
+	testData := []struct {
+		testName   string
+		preset     string
+		expected   []string
+		shouldFail bool
+	}{
+		{testName: "no preset", preset: "", expected: []string{"istio", "monitoring", "knative-eventing"}},
+		{testName: "default preset", preset: PresetDefault, expected: []string{"istio", "monitoring", "knative-eventing"}},
+		{testName: "minimal preset", preset: PresetMinimal, expected: []string{"istio"}},
+		{testName: "full preset", preset: PresetFull, expected: []string{"istio", "monitoring", "knative-eventing", "knative-provisioner-natss", "nats-streaming"}},
+		{testName: "unknown preset", preset: "huge", shouldFail: true},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.testName, func(t *testing.T) {
+			files := []File{
+				{
+					{"kind": "ServiceAccount"},
+					{
+						"kind": "Installation",
+						"spec": map[interface{}]interface{}{
+							"components": []interface{}{
+								map[interface{}]interface{}{"name": "istio", "namespace": "istio-system"},
+								map[interface{}]interface{}{"name": "monitoring", "namespace": "kyma-system"},
+								map[interface{}]interface{}{"name": "knative-eventing", "namespace": "knative-eventing"},
+							},
+						},
+					},
+				},
+			}
+
+			err := applyPreset(files, tt.preset)
+			if tt.shouldFail {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, c := range files[0][1]["spec"].(map[interface{}]interface{})["components"].([]interface{}) {
+				names = append(names, componentName(c))
+			}
+			require.Equal(t, tt.expected, names)
+		})
+	}
+
+	require.Error(t, applyPreset([]File{{{"kind": "Installation"}}}, PresetMinimal), "Installations without components must be rejected.")
+}